@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// utf8BOM is the byte order mark some Windows tools write at the start of
+// UTF-8 files and others fail to read.
+var utf8BOM = []byte{0xef, 0xbb, 0xbf}
+
+// addBOM prepends the UTF-8 byte order mark to content unless it has one.
+func addBOM(content []byte) []byte {
+	if bytes.HasPrefix(content, utf8BOM) {
+		return content
+	}
+	return append(append([]byte{}, utf8BOM...), content...)
+}
+
+// stripBOMUpload returns the path to upload the file at path from. Files
+// starting with a UTF-8 byte order mark are copied without it to a file of
+// the same name in a new temporary directory, which the caller must remove.
+// Otherwise dir is empty and the file is uploaded as it is, so the mark
+// doesn't end up in the first key.
+func stripBOMUpload(path string) (dir, upload string, err error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", "", err
+	}
+	if !bytes.HasPrefix(content, utf8BOM) {
+		return "", path, nil
+	}
+	logger.Debugf("Removing byte order mark from %s", path)
+
+	dir, err = ioutil.TempDir("", "phraseapp-upload")
+	if err != nil {
+		return "", "", err
+	}
+	upload = filepath.Join(dir, filepath.Base(path))
+	if err := ioutil.WriteFile(upload, content[len(utf8BOM):], 0600); err != nil {
+		os.RemoveAll(dir)
+		return "", "", err
+	}
+	return dir, upload, nil
+}
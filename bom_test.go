@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/gopkg.in/yaml.v2"
+)
+
+func TestAddBOM(t *testing.T) {
+	expected := append([]byte{0xef, 0xbb, 0xbf}, "en: x"...)
+	if out := addBOM([]byte("en: x")); !bytes.Equal(out, expected) {
+		t.Errorf("expected %q, got %q", expected, out)
+	}
+	if out := addBOM(expected); !bytes.Equal(out, expected) {
+		t.Errorf("expected the byte order mark to be added once, got %q", out)
+	}
+}
+
+func TestStripBOMUpload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp-bom-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	plain := filepath.Join(dir, "en.json")
+	if err := ioutil.WriteFile(plain, []byte(`{"a": "b"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tmp, path, err := stripBOMUpload(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tmp != "" || path != plain {
+		t.Errorf("expected a file without byte order mark to be uploaded as it is, got %s", path)
+	}
+
+	marked := filepath.Join(dir, "de.json")
+	if err := ioutil.WriteFile(marked, addBOM([]byte(`{"a": "b"}`)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tmp, path, err = stripBOMUpload(marked)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	if tmp == "" || filepath.Base(path) != "de.json" {
+		t.Fatalf("expected a copy named de.json in a temporary directory, got %s", path)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != `{"a": "b"}` {
+		t.Errorf("expected the byte order mark to be removed, got %q", content)
+	}
+}
+
+func TestTargetEncodeBOM(t *testing.T) {
+	target := getBaseTarget()
+	target.BOM = true
+	if err := target.checkEncoding(); err != nil {
+		t.Fatal(err)
+	}
+	out, err := target.encode([]byte("en: x"), "yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(out, utf8BOM) {
+		t.Errorf("expected a byte order mark, got %q", out)
+	}
+
+	target.Encoding = "UTF-16"
+	if err := target.checkEncoding(); err == nil {
+		t.Errorf("expected an error for a UTF-8 byte order mark in UTF-16 files")
+	}
+}
+
+func TestSourceStripBOM(t *testing.T) {
+	tmp := struct{ Sources Sources }{}
+	err := yaml.Unmarshal([]byte("sources:\n- file: ./a/<locale_code>.json\n- file: ./b/<locale_code>.json\n  strip_bom: false\n"), &tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tmp.Sources[0].KeepBOM || !tmp.Sources[1].KeepBOM {
+		t.Errorf("expected only the second source to keep the byte order mark")
+	}
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+const cacheName = ".phraseapp.cache"
+
+// CacheFile overrides the location of the cache. Relative
+// paths are relative to the directory of the configuration file.
+var CacheFile string
+
+// errNotModified is returned for downloads the server reported as unchanged.
+var errNotModified = errors.New("not modified")
+
+// Cache stores the ETags of downloaded locales and checksums of uploaded
+// files, so unchanged locales aren't downloaded or uploaded again. The cache
+// only saves requests, deleting it is safe.
+type Cache struct {
+	path     string
+	entries  map[string]*downloadEntry
+	uploads  map[string]string
+	lastPull time.Time
+	changed  bool
+}
+
+type cacheFile struct {
+	LastPull *time.Time                `json:"last_pull,omitempty"`
+	Entries  map[string]*downloadEntry `json:"entries"`
+	Uploads  map[string]string         `json:"uploads,omitempty"`
+}
+
+type downloadEntry struct {
+	ETag     string `json:"etag"`
+	Checksum string `json:"checksum"`
+}
+
+func cachePath() string {
+	if CacheFile == "" {
+		return filepath.Join(configDir(), cacheName)
+	}
+	if filepath.IsAbs(CacheFile) {
+		return CacheFile
+	}
+	return filepath.Join(configDir(), CacheFile)
+}
+
+// LoadCache reads the cache at path. A missing or unreadable cache
+// results in an empty one.
+func LoadCache(path string) *Cache {
+	cache := &Cache{path: path, entries: map[string]*downloadEntry{}, uploads: map[string]string{}}
+	content, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return cache
+	case err != nil:
+		logger.Warnf("ignoring cache %s: %s", path, err)
+		return cache
+	}
+
+	file := &cacheFile{}
+	if err := json.Unmarshal(content, file); err != nil {
+		logger.Warnf("ignoring cache %s: %s", path, err)
+		return cache
+	}
+	if file.Entries != nil {
+		cache.entries = file.Entries
+	}
+	if file.Uploads != nil {
+		cache.uploads = file.Uploads
+	}
+	if file.LastPull != nil {
+		cache.lastPull = *file.LastPull
+	}
+	return cache
+}
+
+func downloadCacheKey(projectID, localeID, format, path string) string {
+	return strings.Join([]string{projectID, localeID, format, path}, "|")
+}
+
+// ETag returns the ETag stored for key. It's only returned if the file at path
+// still has the content it was downloaded with, so local changes are
+// overwritten as before.
+func (cache *Cache) ETag(key, path string) string {
+	if cache == nil {
+		return ""
+	}
+	entry, found := cache.entries[key]
+	if !found {
+		return ""
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil || checksum(content) != entry.Checksum {
+		return ""
+	}
+	return entry.ETag
+}
+
+func (cache *Cache) Store(key, etag string, content []byte) {
+	if cache == nil {
+		return
+	}
+	if etag == "" {
+		if _, found := cache.entries[key]; found {
+			delete(cache.entries, key)
+			cache.changed = true
+		}
+		return
+	}
+	cache.entries[key] = &downloadEntry{ETag: etag, Checksum: checksum(content)}
+	cache.changed = true
+}
+
+// LastPull returns the time the last successful pull was started at.
+func (cache *Cache) LastPull() time.Time {
+	if cache == nil {
+		return time.Time{}
+	}
+	return cache.lastPull
+}
+
+func (cache *Cache) SetLastPull(t time.Time) {
+	if cache == nil {
+		return
+	}
+	cache.lastPull = t
+	cache.changed = true
+}
+
+// Uploaded reports whether the file for key was uploaded with checksum before.
+func (cache *Cache) Uploaded(key, checksum string) bool {
+	return cache != nil && cache.uploads[key] == checksum
+}
+
+func (cache *Cache) StoreUpload(key, checksum string) {
+	if cache == nil {
+		return
+	}
+	cache.uploads[key] = checksum
+	cache.changed = true
+}
+
+// Save writes the cache if it was changed.
+func (cache *Cache) Save() error {
+	if cache == nil || !cache.changed {
+		return nil
+	}
+	file := &cacheFile{Entries: cache.entries, Uploads: cache.uploads}
+	if !cache.lastPull.IsZero() {
+		file.LastPull = &cache.lastPull
+	}
+	content, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(cache.path, content, 0600); err != nil {
+		return err
+	}
+	cache.changed = false
+	return nil
+}
+
+func checksum(content []byte) string {
+	sum := sha1.Sum(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// conditionalTransport sends the ETag given with If-None-Match and records the
+// ETag of the response. A 304 response results in errNotModified.
+type conditionalTransport struct {
+	next http.RoundTripper
+	etag string
+
+	responseETag string
+}
+
+func (t *conditionalTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.etag != "" {
+		r := new(http.Request)
+		*r = *req
+		r.Header = http.Header{}
+		for k, v := range req.Header {
+			r.Header[k] = v
+		}
+		r.Header.Set("If-None-Match", t.etag)
+		req = r
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		return nil, errNotModified
+	}
+	t.responseETag = resp.Header.Get("ETag")
+	return resp, nil
+}
+
+// conditionalClient returns a copy of client sending its requests through a
+// conditionalTransport for etag.
+func conditionalClient(client *phraseapp.Client, etag string) (*phraseapp.Client, *conditionalTransport) {
+	next := client.Client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	t := &conditionalTransport{next: next, etag: etag}
+
+	c := *client
+	c.Client.Transport = t
+	return &c, t
+}
+
+func isNotModified(err error) bool {
+	if uerr, ok := err.(*url.Error); ok {
+		err = uerr.Err
+	}
+	return err == errNotModified
+}
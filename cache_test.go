@@ -0,0 +1,83 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConditionalDownload(t *testing.T) {
+	requests := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte("en:\n  hello: Hello\n"))
+	}))
+	defer s.Close()
+
+	dir, err := ioutil.TempDir("", "phraseapp-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, cacheName)
+	target := getBaseTarget()
+	target.Downloads = LoadCache(path)
+	localeFile := &LocaleFile{ID: "en-id", FileFormat: "yml", Path: filepath.Join(dir, "en.yml")}
+	client := newTestClient(s.URL)
+
+	if err := target.DownloadAndWriteToFile(client, localeFile); err != nil {
+		t.Fatal(err)
+	}
+	if err := target.Downloads.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A fresh cache read from disk must result in a conditional request.
+	target.Downloads = LoadCache(path)
+	if err := target.DownloadAndWriteToFile(client, localeFile); err != errNotModified {
+		t.Errorf("expected unchanged locale to be reported as not modified, got %v", err)
+	}
+
+	// Local changes are overwritten, the cached ETag doesn't apply anymore.
+	if err := ioutil.WriteFile(localeFile.Path, []byte("changed"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := target.DownloadAndWriteToFile(client, localeFile); err != nil {
+		t.Fatal(err)
+	}
+	content, err := ioutil.ReadFile(localeFile.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "en:\n  hello: Hello\n" {
+		t.Errorf("expected local changes to be overwritten, got %q", content)
+	}
+
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestLoadCacheInvalid(t *testing.T) {
+	f, err := ioutil.TempFile("", "phraseapp-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("not json")
+	f.Close()
+
+	cache := LoadCache(f.Name())
+	if len(cache.entries) != 0 {
+		t.Errorf("expected an invalid cache to be ignored, got %v", cache.entries)
+	}
+}
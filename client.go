@@ -1,32 +1,268 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
+	"runtime"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
 )
 
 func newClient(creds *phraseapp.Credentials) (*phraseapp.Client, error) {
+	return newClientContext(commandContext, creds)
+}
+
+// newClientContext returns a client whose requests are aborted once ctx is
+// done.
+func newClientContext(ctx context.Context, creds *phraseapp.Credentials) (*phraseapp.Client, error) {
+	verbose := creds.Debug || Debug
 	c, err := phraseapp.NewClient(creds)
 	if err != nil {
 		return nil, err
 	}
-	if os.Getenv("PHRASEAPP_INSECURE_SKIP_VERIFY") == "true" {
-		tr := &http.Transport{
-			Proxy: http.ProxyFromEnvironment,
-			Dial: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-			}).Dial,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-			TLSClientConfig:       &tls.Config{InsecureSkipVerify: true},
-		}
-		c.Client = http.Client{Transport: tr}
+	addSecret(c.Credentials.Token)
+	var tr http.RoundTripper = sharedTransport()
+	if requestLimiter != nil {
+		tr = &rateLimitTransport{next: tr, limiter: requestLimiter}
+	}
+	tr = &contextTransport{next: tr, ctx: ctx}
+	if verbose {
+		// The library's own debug output contains the access token, the
+		// verbose transport logs the same information with it redacted.
+		phraseapp.Debug = false
+		tr = &verboseTransport{next: tr, out: &redactingWriter{w: verboseOutput}}
+	}
+	tr = &cachingTransport{next: tr, cache: requestCache}
+	tr = &userAgentTransport{next: tr}
+	if len(Headers) > 0 {
+		tr = &headerTransport{next: tr, header: Headers}
 	}
+	if Branch != "" {
+		tr = &branchTransport{next: tr, branch: Branch}
+	}
+	c.Client = http.Client{Transport: tr}
+	logger.Debugf("Using API host %s (branch %q)", c.Credentials.Host, Branch)
 	return c, nil
 }
+
+// MaxIdleConns is the number of idle keep-alive connections kept open to the
+// API, set by the max_idle_conns configuration key.
+var MaxIdleConns = 8
+
+var (
+	transportOnce sync.Once
+	transport     *http.Transport
+)
+
+// sharedTransport returns the transport all clients send their requests
+// through, so they reuse keep-alive connections instead of doing a TLS
+// handshake for every client.
+func sharedTransport() *http.Transport {
+	transportOnce.Do(func() {
+		transport = newTransport(os.Getenv("PHRASEAPP_INSECURE_SKIP_VERIFY") == "true", MaxIdleConns)
+	})
+	return transport
+}
+
+func newTransport(insecure bool, maxIdleConns int) *http.Transport {
+	tr := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		Dial: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).Dial,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		MaxIdleConnsPerHost:   maxIdleConns,
+	}
+	if insecure {
+		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	return tr
+}
+
+// contextTransport sends requests with ctx, so they are aborted once ctx is
+// done.
+type contextTransport struct {
+	next http.RoundTripper
+	ctx  context.Context
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(req.WithContext(t.ctx))
+}
+
+// clientPool hands out one client per access token, so targets and sources
+// configured with their own token don't use the default one.
+type clientPool struct {
+	ctx     context.Context
+	creds   *phraseapp.Credentials
+	clients map[string]*phraseapp.Client
+}
+
+func newClientPool(ctx context.Context, creds *phraseapp.Credentials) *clientPool {
+	return &clientPool{ctx: ctx, creds: creds, clients: map[string]*phraseapp.Client{}}
+}
+
+// aborted tells whether the command was interrupted or timed out, so failing
+// requests don't need to be tried for the remaining files.
+func (pool *clientPool) aborted() bool {
+	return pool.ctx.Err() != nil
+}
+
+// Client returns the client for token. An empty token results in the default
+// credentials being used.
+func (p *clientPool) Client(token string) (*phraseapp.Client, error) {
+	if c, found := p.clients[token]; found {
+		return c, nil
+	}
+
+	creds := *p.creds
+	if token != "" {
+		creds.Token = token
+		creds.Username = ""
+	}
+	c, err := newClientContext(p.ctx, &creds)
+	if err != nil {
+		return nil, err
+	}
+	if c.Credentials.Token == "" && c.Credentials.Username == "" {
+		return nil, fmt.Errorf("no access token given")
+	}
+
+	p.clients[token] = c
+	return c, nil
+}
+
+var verboseOutput io.Writer = os.Stderr
+
+var redactedHeaders = []string{"Authorization", "X-PhraseApp-OTP"}
+
+// verboseTransport logs every request and response passing through it.
+type verboseTransport struct {
+	next http.RoundTripper
+	out  io.Writer
+}
+
+func (t *verboseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fmt.Fprintf(t.out, "--> %s %s\n", req.Method, req.URL)
+	writeHeaders(t.out, req.Header)
+
+	if req.Body != nil && strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		fmt.Fprintf(t.out, "    %s\n", bytes.TrimSpace(body))
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	elapsed := time.Since(start)
+	if err != nil {
+		fmt.Fprintf(t.out, "<-- %s %s failed after %s: %s\n", req.Method, req.URL, elapsed, err)
+		return nil, err
+	}
+
+	fmt.Fprintf(t.out, "<-- %s (%s)\n", resp.Status, elapsed)
+	writeHeaders(t.out, resp.Header)
+	if status := rateLimitStatus(resp.Header); status != "" {
+		fmt.Fprintf(t.out, "    %s\n", status)
+	}
+
+	// Error responses are small, their body shows e.g. the raw validation
+	// errors.
+	if resp.StatusCode >= 400 && strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		fmt.Fprintf(t.out, "    %s\n", bytes.TrimSpace(body))
+	}
+	return resp, nil
+}
+
+func writeHeaders(w io.Writer, header http.Header) {
+	redacted := http.Header{}
+	for k, v := range header {
+		redacted[k] = v
+	}
+	for _, name := range redactedHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, "[REDACTED]")
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	redacted.Write(buf)
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			fmt.Fprintf(w, "    %s\n", line)
+		}
+	}
+}
+
+// branchTransport sends all requests for the given branch.
+type branchTransport struct {
+	next   http.RoundTripper
+	branch string
+}
+
+func (t *branchTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := new(http.Request)
+	*r = *req
+	u := *req.URL
+	r.URL = &u
+
+	query := u.Query()
+	query.Set("branch", t.branch)
+	r.URL.RawQuery = query.Encode()
+	return t.next.RoundTrip(r)
+}
+
+// UserAgent is appended to the User-Agent header of all requests, set with the
+// --user-agent option or the user_agent configuration key.
+var UserAgent string
+
+// userAgent returns the User-Agent for requests sent by the library with base,
+// adding the client version and operating system and UserAgent.
+func userAgent(base string) string {
+	agent := fmt.Sprintf("phraseapp-client/%s (%s/%s)", PHRASEAPP_CLIENT_VERSION, runtime.GOOS, runtime.GOARCH)
+	if base != "" {
+		agent = base + " " + agent
+	}
+	if UserAgent != "" {
+		agent += " " + UserAgent
+	}
+	return agent
+}
+
+// userAgentTransport identifies the client in the User-Agent header.
+type userAgentTransport struct {
+	next http.RoundTripper
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := new(http.Request)
+	*r = *req
+	r.Header = http.Header{}
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	r.Header.Set("User-Agent", userAgent(req.Header.Get("User-Agent")))
+	return t.next.RoundTrip(r)
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestVerboseTransportRedactsToken(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit-Remaining", "999")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	out := &bytes.Buffer{}
+	c := http.Client{Transport: &verboseTransport{next: http.DefaultTransport, out: out}}
+
+	req, err := http.NewRequest("GET", s.URL+"/v2/projects", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "token secret-token")
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	log := out.String()
+	if strings.Contains(log, "secret-token") {
+		t.Errorf("expected access token to be redacted, got:\n%s", log)
+	}
+	for _, expected := range []string{"--> GET " + s.URL + "/v2/projects", "Authorization: [REDACTED]", "<-- 200 OK", "X-Rate-Limit-Remaining: 999"} {
+		if !strings.Contains(log, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, log)
+		}
+	}
+}
+
+func TestVerboseTransportErrorBody(t *testing.T) {
+	payload := `{"message": "Validation failed", "errors": [{"resource": "key", "field": "name", "message": "has already been taken"}]}`
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(422)
+		io.WriteString(w, payload)
+	}))
+	defer s.Close()
+
+	out := &bytes.Buffer{}
+	c := http.Client{Transport: &verboseTransport{next: http.DefaultTransport, out: out}}
+	resp, err := c.Get(s.URL + "/v2/projects/project-id/keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(out.String(), payload) {
+		t.Errorf("expected the error response to be logged, got:\n%s", out)
+	}
+	if string(body) != payload {
+		t.Errorf("expected the body to be readable after logging, got %q", body)
+	}
+}
+
+func TestBranchTransport(t *testing.T) {
+	var query string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+	}))
+	defer s.Close()
+
+	c := http.Client{Transport: &branchTransport{next: http.DefaultTransport, branch: "feature"}}
+	resp, err := c.Get(s.URL + "/v2/projects?page=2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if expected := "branch=feature&page=2"; query != expected {
+		t.Errorf("expected query %q, got %q", expected, query)
+	}
+}
+
+func TestClientPool(t *testing.T) {
+	pool := newClientPool(context.Background(), &phraseapp.Credentials{Token: "global-token", Host: "http://localhost"})
+
+	c, err := pool.Client("target-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Credentials.Token != "target-token" {
+		t.Errorf("expected the target token to override the global one, got %q", c.Credentials.Token)
+	}
+	if again, _ := pool.Client("target-token"); again != c {
+		t.Errorf("expected the client to be reused for the same token")
+	}
+
+	c, err = pool.Client("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Credentials.Token != "global-token" {
+		t.Errorf("expected the global token to be used, got %q", c.Credentials.Token)
+	}
+	if pool.creds.Token != "global-token" {
+		t.Errorf("expected the global credentials to be left untouched, got %q", pool.creds.Token)
+	}
+}
+
+func TestClientPoolMissingToken(t *testing.T) {
+	envToken := os.Getenv("PHRASEAPP_ACCESS_TOKEN")
+	os.Unsetenv("PHRASEAPP_ACCESS_TOKEN")
+	defer os.Setenv("PHRASEAPP_ACCESS_TOKEN", envToken)
+
+	pool := newClientPool(context.Background(), &phraseapp.Credentials{Host: "http://localhost"})
+	if _, err := pool.Client(""); err == nil {
+		t.Errorf("expected an error for a missing access token")
+	}
+}
+
+func TestClientsShareTransport(t *testing.T) {
+	pool := newClientPool(context.Background(), &phraseapp.Credentials{Token: "global-token", Host: "http://localhost"})
+	a, err := pool.Client("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := pool.Client("target-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []*phraseapp.Client{a, b} {
+		var cached *cachingTransport
+		if tr, ok := c.Client.Transport.(*userAgentTransport); ok {
+			cached, _ = tr.next.(*cachingTransport)
+		}
+		var withContext *contextTransport
+		if cached != nil {
+			withContext, _ = cached.next.(*contextTransport)
+		}
+		if withContext == nil || withContext.next != sharedTransport() || cached.cache != requestCache {
+			t.Errorf("expected all clients to use the shared transport and cache, got %#v", c.Client.Transport)
+		}
+	}
+}
+
+func TestNewTransport(t *testing.T) {
+	tr := newTransport(false, 16)
+	if tr.MaxIdleConnsPerHost != 16 {
+		t.Errorf("expected 16 idle connections, got %d", tr.MaxIdleConnsPerHost)
+	}
+	if tr.TLSClientConfig != nil {
+		t.Errorf("expected the default TLS configuration")
+	}
+
+	if tr := newTransport(true, 1); tr.TLSClientConfig == nil || !tr.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("expected certificate verification to be skipped")
+	}
+}
+
+func TestUserAgentTransport(t *testing.T) {
+	defer func() { UserAgent = "" }()
+
+	agent := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agent = r.Header.Get("User-Agent")
+	}))
+	defer srv.Close()
+
+	UserAgent = "deploy-bot/1.0"
+	req, _ := http.NewRequest("GET", srv.URL, nil)
+	req.Header.Set("User-Agent", "PhraseApp go (1.2.3)")
+	resp, err := (&http.Client{Transport: &userAgentTransport{next: http.DefaultTransport}}).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if !strings.HasPrefix(agent, "PhraseApp go (1.2.3) phraseapp-client/") || !strings.HasSuffix(agent, ") deploy-bot/1.0") {
+		t.Errorf("expected the custom agent to be appended to the client's, got %q", agent)
+	}
+	if req.Header.Get("User-Agent") != "PhraseApp go (1.2.3)" {
+		t.Errorf("expected the original request to be left untouched")
+	}
+}
@@ -0,0 +1,316 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/gopkg.in/yaml.v2"
+)
+
+const configName = ".phraseapp.yml"
+
+// Branch all requests are sent for. Empty means the main branch.
+var Branch string
+
+// ConfigFile is the configuration file given with --config.
+var ConfigFile string
+
+// ConfigInline is the configuration given as YAML with --config-inline,
+// e.g. in CI jobs without a configuration file.
+var ConfigInline string
+
+// BaseDir is the directory relative file patterns of sources and targets are
+// resolved against, set with --base-dir. See patternDir for the default.
+var BaseDir string
+
+// ProjectIDOverride replaces the configured project ID, including the ones of
+// pull targets and push sources.
+var ProjectIDOverride string
+
+// clientConfigKeys are configuration keys only known to the client. They are
+// removed before the configuration is handed to the library, which rejects
+// unknown keys. Values given as options take precedence over the config.
+var clientConfigKeys = map[string]func(value interface{}) error{
+	"branch": func(value interface{}) error {
+		branch, err := phraseapp.ValidateIsString("branch", value)
+		if err == nil && Branch == "" {
+			Branch = branch
+		}
+		return err
+	},
+	"user_agent": func(value interface{}) error {
+		agent, err := phraseapp.ValidateIsString("user_agent", value)
+		if err == nil && UserAgent == "" {
+			UserAgent = agent
+		}
+		return err
+	},
+	"disable_error_reporting": func(value interface{}) error {
+		disable, err := phraseapp.ValidateIsBool("disable_error_reporting", value)
+		if disable {
+			DisableErrorReporting = true
+		}
+		return err
+	},
+	"out_dir": func(value interface{}) error {
+		dir, err := phraseapp.ValidateIsString("out_dir", value)
+		if err == nil && OutDir == "" {
+			OutDir = dir
+		}
+		return err
+	},
+	"max_idle_conns": func(value interface{}) error {
+		n, err := phraseapp.ValidateIsInt("max_idle_conns", value)
+		if err == nil && n < 1 {
+			err = fmt.Errorf("max_idle_conns must be at least 1, got %d", n)
+		}
+		if err == nil {
+			MaxIdleConns = n
+		}
+		return err
+	},
+	"cache_file": func(value interface{}) error {
+		path, err := phraseapp.ValidateIsString("cache_file", value)
+		if err == nil && CacheFile == "" {
+			CacheFile = path
+		}
+		return err
+	},
+}
+
+// ReadConfig reads the inline configuration if there is one, see
+// inlineConfig, or the configuration file, see configPath for how it is found.
+func ReadConfig() (*phraseapp.Config, error) {
+	if content := inlineConfig(); content != "" {
+		cfg, err := ParseConfig([]byte(content))
+		if err != nil {
+			return nil, fmt.Errorf("invalid inline configuration: %s", err)
+		}
+		return cfg, nil
+	}
+
+	path, err := configPath()
+	switch {
+	case err != nil:
+		return nil, err
+	case path == "":
+		return ParseConfig(nil)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfig(content)
+}
+
+// ParseConfig parses the content of a configuration file, applying the client
+// specific keys and passing the remaining ones to the library. Environment
+// variables in values are expanded, see expandEnv.
+func ParseConfig(content []byte) (*phraseapp.Config, error) {
+	cfg := &phraseapp.Config{Credentials: new(phraseapp.Credentials)}
+
+	raw := map[string]map[string]interface{}{}
+	if err := yaml.Unmarshal(content, &raw); err != nil {
+		return nil, err
+	}
+	for _, section := range raw {
+		expandEnvValues(section)
+	}
+
+	if section, found := raw["phraseapp"]; found {
+		if err := applyEnvironment(section); err != nil {
+			return nil, err
+		}
+		for key, apply := range clientConfigKeys {
+			value, found := section[key]
+			if !found {
+				continue
+			}
+			if err := apply(value); err != nil {
+				return nil, err
+			}
+			delete(section, key)
+		}
+	} else if Environment != "" {
+		return nil, fmt.Errorf("environment %q given with --env not found, there is no configuration", Environment)
+	}
+
+	content, err := yaml.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapper := struct {
+		PhraseApp *phraseapp.Config `yaml:"phraseapp"`
+	}{PhraseApp: cfg}
+	if err := yaml.Unmarshal(content, &wrapper); err != nil {
+		return nil, err
+	}
+
+	if ProjectIDOverride != "" {
+		cfg.DefaultProjectID = ProjectIDOverride
+	}
+
+	if cfg.PerPage != nil {
+		if err := validatePerPage(*cfg.PerPage); err != nil {
+			return nil, fmt.Errorf("invalid perpage in %s: %s", configName, err)
+		}
+	}
+	return cfg, nil
+}
+
+// inlineConfig returns the configuration given with --config-inline, or as
+// content of the PHRASEAPP_CONFIG environment variable.
+func inlineConfig() string {
+	if ConfigInline != "" {
+		return ConfigInline
+	}
+	if envConfig := os.Getenv("PHRASEAPP_CONFIG"); isInlineConfig(envConfig) {
+		return envConfig
+	}
+	return ""
+}
+
+// isInlineConfig tells configuration content apart from a path, as both are
+// accepted in PHRASEAPP_CONFIG.
+func isInlineConfig(value string) bool {
+	return strings.Contains(value, "\n") || strings.HasPrefix(value, "{") || strings.HasPrefix(value, "phraseapp:")
+}
+
+// configPath returns the path of the configuration file. The file can be set
+// with --config or the PHRASEAPP_CONFIG environment variable, otherwise it's
+// looked up in the working directory and its parents, see findConfig, and
+// finally the home directory. Returns an empty path if there
+// is none or the configuration is given inline.
+func configPath() (string, error) {
+	if inlineConfig() != "" {
+		return "", nil
+	}
+
+	if ConfigFile != "" {
+		switch _, err := os.Stat(ConfigFile); {
+		case err == nil:
+			return ConfigFile, nil
+		case os.IsNotExist(err):
+			return "", fmt.Errorf("file %q (given with --config) doesn't exist", ConfigFile)
+		default:
+			return "", err
+		}
+	}
+
+	if envConfig := os.Getenv("PHRASEAPP_CONFIG"); envConfig != "" {
+		switch _, err := os.Stat(envConfig); {
+		case err == nil:
+			return envConfig, nil
+		case os.IsNotExist(err):
+			return "", fmt.Errorf("file %q (given in PHRASEAPP_CONFIG) doesn't exist", envConfig)
+		default:
+			return "", err
+		}
+	}
+
+	if path := findConfig(workingDir()); path != "" {
+		return path, nil
+	}
+	if dir := homeDir(); dir != "" {
+		if path := filepath.Join(dir, configName); Exists(path) == nil {
+			return path, nil
+		}
+	}
+	return "", nil
+}
+
+// findConfig looks for the configuration file in dir and its parents, like git
+// does for its repository. The search stops at the root of a repository, i.e. a
+// directory containing .git, or of the file system. Returns an empty path if
+// there is none.
+func findConfig(dir string) string {
+	for dir != "" {
+		if path := filepath.Join(dir, configName); Exists(path) == nil {
+			return path
+		}
+		if Exists(filepath.Join(dir, ".git")) == nil {
+			return ""
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+	return ""
+}
+
+// configDir returns the directory of the configuration file. Falls back to the
+// working directory if there is no configuration file.
+func configDir() string {
+	path, err := configPath()
+	if err != nil || path == "" {
+		return workingDir()
+	}
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return workingDir()
+	}
+	return dir
+}
+
+// patternDir returns the directory relative file patterns of the configuration
+// are resolved against, --base-dir or the directory of the configuration file.
+// A configuration in the home directory is shared by projects, so its patterns
+// are relative to the working directory, as without configuration file.
+func patternDir() string {
+	if BaseDir != "" {
+		if dir, err := filepath.Abs(BaseDir); err == nil {
+			return dir
+		}
+	}
+	path, err := configPath()
+	if err != nil || path == "" {
+		return workingDir()
+	}
+	dir := configDir()
+	if home := homeDir(); home != "" {
+		if home, err := filepath.Abs(home); err == nil && home == dir {
+			return workingDir()
+		}
+	}
+	return dir
+}
+
+// resolvePattern resolves the relative file pattern against patternDir. The
+// result is relative to the working directory if possible, so messages show
+// short paths.
+func resolvePattern(pattern string) string {
+	if pattern == "" || filepath.IsAbs(pattern) {
+		return pattern
+	}
+	wd := workingDir()
+	dir := patternDir()
+	if dir == wd {
+		return pattern
+	}
+	resolved := filepath.Join(dir, pattern)
+	if rel, err := filepath.Rel(wd, resolved); err == nil {
+		return rel
+	}
+	return resolved
+}
+
+func workingDir() string {
+	wd, _ := os.Getwd()
+	return wd
+}
+
+func homeDir() string {
+	if runtime.GOOS == "windows" {
+		return os.Getenv("HomePath")
+	}
+	return os.Getenv("HOME")
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"os"
+	"regexp"
+)
+
+// envPattern matches ${VAR} and ${VAR:-default}.
+var envPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// expandEnv replaces ${VAR} with the value of the environment variable VAR and
+// ${VAR:-default} with default if VAR is unset or empty.
+func expandEnv(value string) string {
+	return envPattern.ReplaceAllStringFunc(value, func(match string) string {
+		groups := envPattern.FindStringSubmatch(match)
+		if v := os.Getenv(groups[1]); v != "" || groups[2] == "" {
+			return v
+		}
+		return groups[3]
+	})
+}
+
+// expandEnvValues expands environment variables in all strings of a parsed
+// YAML value, including the ones nested in maps and lists.
+func expandEnvValues(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return expandEnv(v)
+	case map[interface{}]interface{}:
+		for key, item := range v {
+			v[key] = expandEnvValues(item)
+		}
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = expandEnvValues(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = expandEnvValues(item)
+		}
+	}
+	return value
+}
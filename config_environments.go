@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Environment selects the section of the environments configuration key
+// merged over the configuration, set with --env.
+var Environment string
+
+// applyEnvironment removes the environments key from the configuration
+// section and merges the settings of the selected Environment over the
+// others, e.g. a project_id, access_token or pull targets for staging.
+func applyEnvironment(section map[string]interface{}) error {
+	value, found := section["environments"]
+	delete(section, "environments")
+	if Environment == "" {
+		return nil
+	}
+
+	environments, ok := value.(map[interface{}]interface{})
+	if !found || !ok {
+		return fmt.Errorf("environment %q given with --env not found, the configuration has no environments", Environment)
+	}
+	env, found := environments[Environment]
+	if !found {
+		names := []string{}
+		for name := range environments {
+			names = append(names, fmt.Sprint(name))
+		}
+		sort.Strings(names)
+		return fmt.Errorf("environment %q given with --env not found, available are: %s", Environment, strings.Join(names, ", "))
+	}
+	settings, ok := env.(map[interface{}]interface{})
+	if !ok {
+		return fmt.Errorf("environment %q must be a map of configuration keys", Environment)
+	}
+
+	for key, value := range settings {
+		name := fmt.Sprint(key)
+		section[name] = mergeConfigValue(section[name], value)
+	}
+	return nil
+}
+
+// mergeConfigValue merges override over base. Maps are merged key by key, so
+// an environment can replace the targets of pull without repeating its other
+// settings. Other values are replaced.
+func mergeConfigValue(base, override interface{}) interface{} {
+	baseMap, ok := base.(map[interface{}]interface{})
+	overrideMap, ok2 := override.(map[interface{}]interface{})
+	if !ok || !ok2 {
+		return override
+	}
+
+	merged := map[interface{}]interface{}{}
+	for key, value := range baseMap {
+		merged[key] = value
+	}
+	for key, value := range overrideMap {
+		merged[key] = mergeConfigValue(merged[key], value)
+	}
+	return merged
+}
@@ -0,0 +1,357 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/gopkg.in/yaml.v2"
+)
+
+func TestParseConfigClientKeys(t *testing.T) {
+	defer func() { Branch = "" }()
+
+	cfg, err := ParseConfig([]byte(`
+phraseapp:
+  access_token: some_token
+  project_id: project-id
+  branch: feature
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Token != "some_token" || cfg.DefaultProjectID != "project-id" {
+		t.Errorf("expected library settings to be parsed, got %#v", cfg)
+	}
+	if Branch != "feature" {
+		t.Errorf("expected branch to be %q, got %q", "feature", Branch)
+	}
+}
+
+func TestParseConfigMaxIdleConns(t *testing.T) {
+	defer func() { MaxIdleConns = 8 }()
+
+	if _, err := ParseConfig([]byte("phraseapp:\n  max_idle_conns: 0\n")); err == nil {
+		t.Errorf("expected an error for max_idle_conns below 1")
+	}
+	if _, err := ParseConfig([]byte("phraseapp:\n  max_idle_conns: 32\n")); err != nil {
+		t.Fatal(err)
+	}
+	if MaxIdleConns != 32 {
+		t.Errorf("expected 32 idle connections, got %d", MaxIdleConns)
+	}
+}
+
+func TestParseConfigOptionPrecedence(t *testing.T) {
+	defer func() { Branch, CacheFile = "", "" }()
+
+	Branch, CacheFile = "from-option", "from-option.cache"
+	if _, err := ParseConfig([]byte("phraseapp:\n  branch: from-config\n  cache_file: from-config.cache\n")); err != nil {
+		t.Fatal(err)
+	}
+	if Branch != "from-option" {
+		t.Errorf("expected option to take precedence, got %q", Branch)
+	}
+	if CacheFile != "from-option.cache" {
+		t.Errorf("expected the cache file set before to take precedence, got %q", CacheFile)
+	}
+}
+
+func TestParseConfigEmpty(t *testing.T) {
+	cfg, err := ParseConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Credentials == nil {
+		t.Errorf("expected credentials to be initialized")
+	}
+}
+
+func TestParseConfigPerPage(t *testing.T) {
+	if _, err := ParseConfig([]byte("phraseapp:\n  perpage: 500\n")); err == nil {
+		t.Errorf("expected an error for perpage above the maximum")
+	}
+	if _, err := ParseConfig([]byte("phraseapp:\n  perpage: 100\n")); err != nil {
+		t.Errorf("didn't expect an error, got %s", err)
+	}
+}
+
+func TestProjectIDPrecedence(t *testing.T) {
+	defer func() { ProjectIDOverride = "" }()
+
+	content := []byte(`phraseapp:
+  access_token: some_token
+  project_id: config-project
+  pull:
+    targets:
+    - file: ./en.yml
+      project_id: target-project
+    - file: ./de.yml
+`)
+
+	for override, expected := range map[string][]string{
+		"":             {"config-project", "target-project", "config-project"},
+		"flag-project": {"flag-project", "flag-project", "flag-project"},
+	} {
+		ProjectIDOverride = override
+		cfg, err := ParseConfig(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		targets, err := TargetsFromConfig(&PullCommand{Config: cfg})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := []string{cfg.DefaultProjectID, targets[0].ProjectID, targets[1].ProjectID}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("expected project IDs %v with override %q, got %v", expected, override, got)
+		}
+	}
+}
+
+func TestExpandEnv(t *testing.T) {
+	os.Setenv("PHRASEAPP_TEST_TOKEN", "secret")
+	os.Setenv("PHRASEAPP_TEST_EMPTY", "")
+	defer os.Unsetenv("PHRASEAPP_TEST_TOKEN")
+	defer os.Unsetenv("PHRASEAPP_TEST_EMPTY")
+
+	for value, expected := range map[string]string{
+		"${PHRASEAPP_TEST_TOKEN}":              "secret",
+		"token-${PHRASEAPP_TEST_TOKEN}-suffix": "token-secret-suffix",
+		"${PHRASEAPP_TEST_UNSET}":              "",
+		"${PHRASEAPP_TEST_UNSET:-fallback}":    "fallback",
+		"${PHRASEAPP_TEST_EMPTY:-fallback}":    "fallback",
+		"${PHRASEAPP_TEST_TOKEN:-fallback}":    "secret",
+		"$PHRASEAPP_TEST_TOKEN":                "$PHRASEAPP_TEST_TOKEN",
+		"./locales/<locale_code>.yml":          "./locales/<locale_code>.yml",
+	} {
+		if got := expandEnv(value); got != expected {
+			t.Errorf("%s: expected %q, got %q", value, expected, got)
+		}
+	}
+}
+
+func TestParseConfigEnv(t *testing.T) {
+	os.Setenv("PHRASEAPP_TEST_TOKEN", "secret")
+	defer os.Unsetenv("PHRASEAPP_TEST_TOKEN")
+
+	cfg, err := ParseConfig([]byte(`
+phraseapp:
+  access_token: ${PHRASEAPP_TEST_TOKEN}
+  project_id: ${PHRASEAPP_TEST_PROJECT:-default-project}
+  pull:
+    targets:
+      - file: ./locales/<locale_code>.yml
+        params: &params
+          file_format: yml
+          tag: ${PHRASEAPP_TEST_TAG:-web}
+      - file: ./locales/<locale_code>.json
+        params:
+          <<: *params
+          file_format: json
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Token != "secret" || cfg.DefaultProjectID != "default-project" {
+		t.Errorf("expected the token and project to be expanded, got %q and %q", cfg.Token, cfg.DefaultProjectID)
+	}
+
+	targets := struct{ Targets []*Target }{}
+	if err := yaml.Unmarshal(cfg.Targets, &targets); err != nil {
+		t.Fatal(err)
+	}
+	if len(targets.Targets) != 2 {
+		t.Fatalf("expected 2 targets, got %d", len(targets.Targets))
+	}
+	if first := targets.Targets[0]; first.GetTag() != "web" || first.GetFormat() != "yml" {
+		t.Errorf("expected the params of the first target to be expanded, got %#v", first.Params)
+	}
+	if second := targets.Targets[1]; second.GetTag() != "web" || second.GetFormat() != "json" {
+		t.Errorf("expected the params of the second target to be merged from the alias, got %#v", second.Params)
+	}
+}
+
+func TestReadConfigInline(t *testing.T) {
+	defer func() { ConfigInline, ConfigFile = "", "" }()
+	defer os.Setenv("PHRASEAPP_CONFIG", os.Getenv("PHRASEAPP_CONFIG"))
+
+	ConfigFile = "does-not-exist.yml"
+	os.Setenv("PHRASEAPP_CONFIG", "phraseapp:\n  project_id: from-env\n  pull:\n    targets:\n    - file: ./<locale_code>.json\n")
+	cfg, err := ReadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.DefaultProjectID != "from-env" || len(cfg.Targets) == 0 {
+		t.Errorf("expected the configuration of PHRASEAPP_CONFIG with targets, got %#v", cfg)
+	}
+
+	ConfigInline = "{phraseapp: {project_id: from-option}}"
+	if cfg, err = ReadConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.DefaultProjectID != "from-option" {
+		t.Errorf("expected --config-inline to take precedence, got %q", cfg.DefaultProjectID)
+	}
+
+	ConfigInline = "phraseapp: ["
+	if _, err := ReadConfig(); err == nil {
+		t.Errorf("expected an error for invalid inline configuration")
+	}
+}
+
+func TestConfigPathOption(t *testing.T) {
+	defer func() { ConfigFile = "" }()
+	defer os.Setenv("PHRASEAPP_CONFIG", os.Getenv("PHRASEAPP_CONFIG"))
+
+	os.Setenv("PHRASEAPP_CONFIG", "")
+	ConfigFile = "does-not-exist.yml"
+	if _, err := configPath(); err == nil {
+		t.Errorf("expected an error for a missing --config file")
+	}
+
+	ConfigFile = "config_test.go"
+	if path, err := configPath(); err != nil || path != ConfigFile {
+		t.Errorf("expected --config to be used, got %q (%v)", path, err)
+	}
+}
+
+func TestTargetsFromConfigCredentials(t *testing.T) {
+	for _, tc := range []struct {
+		content, expected string
+	}{
+		{
+			"phraseapp:\n  access_token: some_token\n  pull:\n    targets:\n    - file: ./en.yml\n      project_id: project-id\n    - file: ./de.yml\n",
+			"pull target 2 (./de.yml) has no project_id",
+		},
+		{
+			"phraseapp:\n  project_id: project-id\n  pull:\n    targets:\n    - file: ./en.yml\n",
+			"pull target 1 (./en.yml) has no access_token",
+		},
+	} {
+		cfg, err := ParseConfig([]byte(tc.content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = TargetsFromConfig(&PullCommand{Config: cfg})
+		if err == nil || !strings.HasPrefix(err.Error(), tc.expected) {
+			t.Errorf("expected error %q, got %v", tc.expected, err)
+		}
+	}
+}
+
+func TestConfigDiscovery(t *testing.T) {
+	defer os.Setenv("PHRASEAPP_CONFIG", os.Getenv("PHRASEAPP_CONFIG"))
+	defer os.Setenv("HOME", os.Getenv("HOME"))
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	root, err := ioutil.TempDir("", "phraseapp-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	// Symlinks in the temp dir would make the working directory differ.
+	if root, err = filepath.EvalSymlinks(root); err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv("PHRASEAPP_CONFIG", "")
+	os.Setenv("HOME", filepath.Join(root, "home"))
+	project := filepath.Join(root, "project")
+	for _, dir := range []string{"home", "project/.git", "project/app/src", "project/nested/.git", "project/nested/src"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, dir := range []string{root, project} {
+		if err := ioutil.WriteFile(filepath.Join(dir, configName), []byte("phraseapp: {}\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tc := range []struct {
+		dir, config, pattern string
+	}{
+		{project, filepath.Join(project, configName), "locales/<locale_code>.yml"},
+		{filepath.Join(project, "app", "src"), filepath.Join(project, configName), "../../locales/<locale_code>.yml"},
+		{filepath.Join(project, "nested", "src"), "", "locales/<locale_code>.yml"},
+	} {
+		if err := os.Chdir(tc.dir); err != nil {
+			t.Fatal(err)
+		}
+		if path, err := configPath(); err != nil || path != tc.config {
+			t.Errorf("%s: expected configuration %q, got %q (%v)", tc.dir, tc.config, path, err)
+		}
+		if got := resolvePattern("locales/<locale_code>.yml"); got != tc.pattern {
+			t.Errorf("%s: expected pattern %q, got %q", tc.dir, tc.pattern, got)
+		}
+	}
+
+	if got := resolvePattern("/abs/<locale_code>.yml"); got != "/abs/<locale_code>.yml" {
+		t.Errorf("expected absolute patterns to be unchanged, got %q", got)
+	}
+}
+
+func TestParseConfigEnvironment(t *testing.T) {
+	defer func() { Environment = "" }()
+
+	content := []byte(`phraseapp:
+  access_token: base-token
+  project_id: base-project
+  pull:
+    targets:
+    - file: ./<locale_code>.yml
+    - file: ./<locale_code>.json
+  environments:
+    staging:
+      project_id: staging-project
+      pull:
+        targets:
+        - file: ./staging/<locale_code>.yml
+    production:
+      access_token: production-token
+`)
+
+	cfg, err := ParseConfig(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.DefaultProjectID != "base-project" || !strings.Contains(string(cfg.Targets), "<locale_code>.json") {
+		t.Errorf("expected the base configuration without --env, got %#v", cfg)
+	}
+
+	Environment = "staging"
+	if cfg, err = ParseConfig(content); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.DefaultProjectID != "staging-project" || cfg.Credentials.Token != "base-token" {
+		t.Errorf("expected the project of staging with the base token, got %q and %q", cfg.DefaultProjectID, cfg.Credentials.Token)
+	}
+	if targets := string(cfg.Targets); !strings.Contains(targets, "staging/") || strings.Contains(targets, ".json") {
+		t.Errorf("expected the targets of staging, got %s", targets)
+	}
+
+	Environment = "production"
+	if cfg, err = ParseConfig(content); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.DefaultProjectID != "base-project" || cfg.Credentials.Token != "production-token" {
+		t.Errorf("expected the base project with the production token, got %q and %q", cfg.DefaultProjectID, cfg.Credentials.Token)
+	}
+
+	Environment = "qa"
+	if _, err := ParseConfig(content); err == nil || !strings.Contains(err.Error(), "available are: production, staging") {
+		t.Errorf("expected an error listing the environments, got %v", err)
+	}
+	if _, err := ParseConfig(nil); err == nil {
+		t.Error("expected an error for --env without configuration")
+	}
+}
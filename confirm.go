@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// AssumeYes skips the confirmation of destructive commands (--yes).
+var AssumeYes bool
+
+// writeScopes are the access token scopes allowing to change data.
+var writeScopes = []string{"write", "admin"}
+
+// confirmDestructive is called by commands deleting or excluding data before
+// they send their request. It fails early if the access token can't change
+// data and asks for confirmation of action when run on a terminal.
+func confirmDestructive(client *phraseapp.Client, action string) error {
+	if err := checkWriteScope(client, action); err != nil {
+		return err
+	}
+	if AssumeYes || !isTerminal(os.Stdin) {
+		return nil
+	}
+	if !askConfirmation(bufio.NewReader(os.Stdin), os.Stderr, action) {
+		return fmt.Errorf("aborted, nothing was changed")
+	}
+	return nil
+}
+
+// checkWriteScope returns an error if the authorization of the access token
+// lacks a write scope. The check is skipped if the authorization can't be
+// looked up, e.g. when authenticating with username and password, or if no
+// authorization on any page belongs to the token.
+func checkWriteScope(client *phraseapp.Client, action string) error {
+	token := client.Credentials.Token
+	if len(token) < 8 {
+		return nil
+	}
+
+	lastEight := token[len(token)-8:]
+	for page := 1; ; page++ {
+		auths, err := client.AuthorizationsList(page, maxPerPage)
+		if err != nil {
+			logger.Debugf("skipped access token scope check: %s", err)
+			return nil
+		}
+
+		for _, auth := range auths {
+			if auth.TokenLastEight != lastEight {
+				continue
+			}
+			for _, scope := range auth.Scopes {
+				for _, s := range writeScopes {
+					if scope == s {
+						return nil
+					}
+				}
+			}
+			return &authError{fmt.Sprintf("access token ...%s has no write scope and can't %s", auth.TokenLastEight, action)}
+		}
+		if len(auths) < maxPerPage {
+			logger.Debugf("skipped access token scope check: no authorization found for access token ...%s", lastEight)
+			return nil
+		}
+	}
+}
+
+// askConfirmation asks on out whether to action and reads the answer from in.
+func askConfirmation(in *bufio.Reader, out io.Writer, action string) bool {
+	fmt.Fprintf(out, "Really %s? Use --yes to skip this question. [y/N]: ", action)
+	line, _ := in.ReadString('\n')
+	return isYes(line)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCheckWriteScope(t *testing.T) {
+	scopes := `"read"`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/authorizations") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		io.WriteString(w, `[{"id":"1","token_last_eight":"12345678","scopes":["write"]},{"id":"2","token_last_eight":"me_token","scopes":[`+scopes+`]}]`)
+	}))
+	defer srv.Close()
+
+	client := newTestClient(srv.URL)
+	if err := checkWriteScope(client, "delete key 1"); err == nil || !strings.Contains(err.Error(), "no write scope") {
+		t.Errorf("expected an error for a read only token, got %v", err)
+	}
+
+	scopes = `"read","write"`
+	if err := checkWriteScope(client, "delete key 1"); err != nil {
+		t.Errorf("expected no error for a token with write scope, got %s", err)
+	}
+
+	client.Credentials.Token = "unknown_token"
+	if err := checkWriteScope(client, "delete key 1"); err != nil {
+		t.Errorf("expected no error for a token without authorization, got %s", err)
+	}
+}
+
+func TestCheckWriteScopePaginated(t *testing.T) {
+	pages := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		if page != "1" {
+			io.WriteString(w, `[{"id":"last","token_last_eight":"me_token","scopes":["read"]}]`)
+			return
+		}
+		auths := make([]string, maxPerPage)
+		for i := range auths {
+			auths[i] = fmt.Sprintf(`{"id":"%d","token_last_eight":"%08d","scopes":["write"]}`, i, i)
+		}
+		io.WriteString(w, "["+strings.Join(auths, ",")+"]")
+	}))
+	defer srv.Close()
+
+	client := newTestClient(srv.URL)
+	if err := checkWriteScope(client, "delete key 1"); err == nil || !strings.Contains(err.Error(), "no write scope") {
+		t.Errorf("expected an error for a read only token on the second page, got %v", err)
+	}
+	if strings.Join(pages, ",") != "1,2" {
+		t.Errorf("expected pages 1 and 2 to be requested, got %v", pages)
+	}
+}
+
+func TestAskConfirmation(t *testing.T) {
+	for answer, expected := range map[string]bool{"y\n": true, "YES\n": true, "\n": false, "no\n": false, "": false} {
+		out := &bytes.Buffer{}
+		if got := askConfirmation(bufio.NewReader(strings.NewReader(answer)), out, "delete key 1"); got != expected {
+			t.Errorf("%q: expected %t, got %t", answer, expected, got)
+		}
+		if !strings.HasPrefix(out.String(), "Really delete key 1?") {
+			t.Errorf("unexpected prompt %q", out.String())
+		}
+	}
+}
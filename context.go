@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Timeout limits the time a command may take, set with --timeout.
+var Timeout time.Duration
+
+// commandContext is the context of the running command. It's canceled when
+// the user interrupts the client with Ctrl-C and ends at the --timeout
+// deadline. It's context.Background() unless set up by Run, e.g. in tests.
+var commandContext = context.Background()
+
+// newCommandContext returns the context for a command, with a deadline if
+// timeout isn't zero.
+func newCommandContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout > 0 {
+		return context.WithTimeout(context.Background(), timeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+func parseTimeout(value string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("expected a positive duration like 5m, got %q", value)
+	}
+	return timeout, nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseTimeout(t *testing.T) {
+	if timeout, err := parseTimeout("90s"); err != nil || timeout != 90*time.Second {
+		t.Errorf("expected 90s, got %s (%v)", timeout, err)
+	}
+	for _, value := range []string{"", "0s", "-1m", "later"} {
+		if _, err := parseTimeout(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
+
+func TestContextTransport(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	ctx, cancel := newCommandContext(50 * time.Millisecond)
+	defer cancel()
+
+	client := &http.Client{Transport: &contextTransport{next: http.DefaultTransport, ctx: ctx}}
+	started := time.Now()
+	if _, err := client.Get(srv.URL); err == nil {
+		t.Fatal("expected the request to be aborted")
+	}
+	if elapsed := time.Since(started); elapsed > 5*time.Second {
+		t.Errorf("expected the request to be aborted at the deadline, took %s", elapsed)
+	}
+	if ctx.Err() != context.DeadlineExceeded {
+		t.Errorf("expected the deadline to be exceeded, got %v", ctx.Err())
+	}
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// DiffCommand shows how the files of the push sources differ from their
+// locales in PhraseApp. Nothing is changed, neither locally nor remotely.
+type DiffCommand struct {
+	*phraseapp.Config
+
+	Context int `cli:"opt --context default=3 desc='number of unchanged lines shown around changes'"`
+}
+
+func (cmd *DiffCommand) Run() error {
+	return cmd.RunContext(commandContext)
+}
+
+// RunContext diffs the sources, ctx being done aborts the requests in flight.
+func (cmd *DiffCommand) RunContext(ctx context.Context) error {
+	client, err := newClientContext(ctx, cmd.Config.Credentials)
+	if err != nil {
+		return err
+	}
+
+	sources, err := SourcesFromConfig(&PushCommand{Config: cmd.Config})
+	if err != nil {
+		return &invalidError{err}
+	}
+
+	formats, err := client.FormatsList(1, maxPerPage)
+	if err == nil {
+		if err := sources.setFormats(formats); err != nil {
+			return &invalidError{err}
+		}
+	}
+
+	ignore, err := LoadIgnoreRules(configDir())
+	if err != nil {
+		return err
+	}
+
+	clients := newClientPool(ctx, cmd.Config.Credentials)
+	changed, total := 0, 0
+	for _, source := range sources {
+		source.Ignore = ignore
+
+		n, c, err := source.Diff(clients, cmd.Context)
+		if err != nil {
+			return err
+		}
+		total += n
+		changed += c
+	}
+
+	if !Quiet {
+		fmt.Fprintf(os.Stderr, "%d of %d files differ\n", changed, total)
+	}
+	return nil
+}
+
+// Diff prints a unified diff from the remote locale to the local file for
+// every file of the source. Returns the number of files compared and the
+// number of files differing.
+func (source *Source) Diff(clients *clientPool, context int) (int, int, error) {
+	if err := source.CheckPreconditions(); err != nil {
+		return 0, 0, err
+	}
+
+	client, err := clients.Client(source.AccessToken)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s for source %s", err, source.File)
+	}
+
+	source.RemoteLocales, err = RemoteLocales(client, source.ProjectID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	localeFiles, err := source.LocaleFiles()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	changed := 0
+	for _, localeFile := range localeFiles {
+		local, err := ioutil.ReadFile(localeFile.Path)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if !localeFile.ExistsRemote {
+			fmt.Printf("Only in %s: no matching locale in PhraseApp\n", localeFile.RelPath())
+			changed++
+			continue
+		}
+
+		params := &phraseapp.LocaleDownloadParams{}
+		if format := source.GetFileFormat(); format != "" {
+			params.FileFormat = &format
+		}
+		if localeFile.Tag != "" {
+			params.Tag = &localeFile.Tag
+		}
+		remote, err := client.LocaleDownload(source.ProjectID, localeFile.ID, params)
+		if err != nil {
+			return 0, 0, fmt.Errorf("%s for %s", err, localeFile.RelPath())
+		}
+
+		diff := unifiedDiff("phraseapp/"+localeFile.Name, localeFile.RelPath(), splitLines(remote), splitLines(local), context)
+		if diff != "" {
+			fmt.Print(diff)
+			changed++
+		}
+	}
+	return len(localeFiles), changed, nil
+}
+
+func splitLines(content []byte) []string {
+	content = bytes.Replace(content, []byte("\r\n"), []byte("\n"), -1)
+	if len(content) == 0 {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+}
+
+// maxDiffCells limits the size of the table used to diff the changed part of
+// two files. Larger changes are shown as removing all old and adding all new
+// lines.
+const maxDiffCells = 1 << 22
+
+type diffOp struct {
+	kind byte // ' ' for unchanged, '-' for removed and '+' for added lines
+	line string
+}
+
+// diffLines returns the edits turning a into b.
+func diffLines(a, b []string) []diffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := []diffOp{}
+	for _, line := range a[:prefix] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	ops = append(ops, diffChanged(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	return ops
+}
+
+// diffChanged diffs a and b using their longest common subsequence.
+func diffChanged(a, b []string) []diffOp {
+	ops := []diffOp{}
+	if len(a)*len(b) > maxDiffCells {
+		for _, line := range a {
+			ops = append(ops, diffOp{'-', line})
+		}
+		for _, line := range b {
+			ops = append(ops, diffOp{'+', line})
+		}
+		return ops
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
+}
+
+// unifiedDiff returns the differences between a and b in unified format with
+// the given number of context lines, or an empty string if they are equal.
+func unifiedDiff(fromName, toName string, a, b []string, context int) string {
+	ops := diffLines(a, b)
+
+	// aLine[i] and bLine[i] are the number of lines of a and b before ops[i].
+	aLine := make([]int, len(ops)+1)
+	bLine := make([]int, len(ops)+1)
+	for i, op := range ops {
+		aLine[i+1], bLine[i+1] = aLine[i], bLine[i]
+		if op.kind != '+' {
+			aLine[i+1]++
+		}
+		if op.kind != '-' {
+			bLine[i+1]++
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			next := end
+			for next < len(ops) && ops[next].kind == ' ' {
+				next++
+			}
+			if next == len(ops) || next-end > 2*context {
+				end += context
+				if end > len(ops) {
+					end = len(ops)
+				}
+				break
+			}
+			end = next
+		}
+
+		if buf.Len() == 0 {
+			fmt.Fprintf(buf, "--- %s\n+++ %s\n", fromName, toName)
+		}
+		fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(aLine[start], aLine[end]-aLine[start]), hunkRange(bLine[start], bLine[end]-bLine[start]))
+		for _, op := range ops[start:end] {
+			fmt.Fprintf(buf, "%c%s\n", op.kind, op.line)
+		}
+		i = end
+	}
+	return buf.String()
+}
+
+func hunkRange(start, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	a := splitLines([]byte("en:\n  a: 1\n  b: 2\n  c: 3\n  d: 4\n  e: 5\n  f: 6\n  g: 7\n  h: 8\n  i: 9\n"))
+	b := splitLines([]byte("en:\n  a: 1\n  b: two\n  c: 3\n  d: 4\n  e: 5\n  f: 6\n  g: 7\n  h: 8\n  i: 9\n  j: 10\n"))
+
+	expected := strings.Join([]string{
+		"--- phraseapp/en",
+		"+++ en.yml",
+		"@@ -2,3 +2,3 @@",
+		"   a: 1",
+		"-  b: 2",
+		"+  b: two",
+		"   c: 3",
+		"@@ -10 +10,2 @@",
+		"   i: 9",
+		"+  j: 10",
+		"",
+	}, "\n")
+	if diff := unifiedDiff("phraseapp/en", "en.yml", a, b, 1); diff != expected {
+		t.Errorf("expected diff\n%s\ngot\n%s", expected, diff)
+	}
+
+	// With more context both changes end up in a single hunk.
+	if diff := unifiedDiff("phraseapp/en", "en.yml", a, b, 4); strings.Count(diff, "@@ -") != 1 {
+		t.Errorf("expected a single hunk, got\n%s", diff)
+	}
+
+	if diff := unifiedDiff("phraseapp/en", "en.yml", a, a, 3); diff != "" {
+		t.Errorf("expected no diff for equal files, got\n%s", diff)
+	}
+}
+
+func TestUnifiedDiffEmpty(t *testing.T) {
+	expected := "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+x\n+y\n"
+	if diff := unifiedDiff("a", "b", nil, splitLines([]byte("x\r\ny\r\n")), 3); diff != expected {
+		t.Errorf("expected %q, got %q", expected, diff)
+	}
+}
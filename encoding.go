@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/golang.org/x/text/encoding"
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/golang.org/x/text/encoding/htmlindex"
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/golang.org/x/text/encoding/ianaindex"
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/golang.org/x/text/encoding/unicode"
+)
+
+// encoder transcodes UTF-8 content as downloaded from PhraseApp to another
+// character encoding.
+type encoder func(content []byte) ([]byte, error)
+
+// utf16 is used for UTF-16 without explicit byte order: little endian with
+// byte order mark, like Windows tools expect it. The IANA default would be
+// big endian.
+var utf16 = unicode.UTF16(unicode.LittleEndian, unicode.UseBOM)
+
+// lookupEncoder returns the encoder for the encoding name. It's nil for UTF-8
+// or an empty name, which means no transcoding.
+func lookupEncoder(name string) (encoder, error) {
+	if name == "" {
+		return nil, nil
+	}
+	enc, err := lookupEncoding(name)
+	if err != nil {
+		return nil, err
+	}
+	if enc == unicode.UTF8 {
+		return nil, nil
+	}
+	return newEncoder(enc, encodingName(enc, name)), nil
+}
+
+// lookupEncoding resolves the IANA name or alias of an encoding, e.g.
+// ISO-8859-1 or latin1, falling back to the labels of the WHATWG Encoding
+// Standard, e.g. utf8. Underscores may be used instead of dashes.
+func lookupEncoding(name string) (encoding.Encoding, error) {
+	for _, candidate := range []string{name, strings.Replace(name, "_", "-", -1)} {
+		if strings.EqualFold(candidate, "utf-16") {
+			return utf16, nil
+		}
+		if enc, err := ianaindex.IANA.Encoding(candidate); err == nil && enc != nil {
+			return enc, nil
+		}
+		if enc, err := htmlindex.Get(candidate); err == nil {
+			return enc, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported encoding %q, use an IANA name like UTF-16LE, ISO-8859-1 or windows-1252", name)
+}
+
+// encodingName returns the preferred name of enc for messages, name if it has
+// none.
+func encodingName(enc encoding.Encoding, name string) string {
+	for _, index := range []*ianaindex.Index{ianaindex.MIME, ianaindex.IANA} {
+		if canonical, err := index.Name(enc); err == nil {
+			return canonical
+		}
+	}
+	return name
+}
+
+// newEncoder returns an encoder to enc. Characters enc can't represent are an
+// error naming the first of them, rather than silently replaced.
+func newEncoder(enc encoding.Encoding, name string) encoder {
+	return func(content []byte) ([]byte, error) {
+		if !utf8.Valid(content) {
+			return nil, fmt.Errorf("content isn't valid UTF-8, can't encode it in %s", name)
+		}
+		out, err := enc.NewEncoder().Bytes(content)
+		if err == nil {
+			return out, nil
+		}
+		line := 1
+		for len(content) > 0 {
+			r, size := utf8.DecodeRune(content)
+			if _, err := enc.NewEncoder().Bytes(content[:size]); err != nil {
+				return nil, fmt.Errorf("character %q in line %d can't be encoded in %s", r, line, name)
+			}
+			if r == '\n' {
+				line++
+			}
+			content = content[size:]
+		}
+		return nil, fmt.Errorf("failed to encode in %s: %s", name, err)
+	}
+}
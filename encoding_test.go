@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestLookupEncoder(t *testing.T) {
+	for _, name := range []string{"", "UTF-8", "utf8"} {
+		enc, err := lookupEncoder(name)
+		if err != nil || enc != nil {
+			t.Errorf("%q: expected no transcoding, got %v", name, err)
+		}
+	}
+	for _, name := range []string{"UTF-16LE", "utf_16le", "ISO-8859-1", "Latin1", "windows-1252"} {
+		if enc, err := lookupEncoder(name); err != nil || enc == nil {
+			t.Errorf("%q: expected an encoder, got %v", name, err)
+		}
+	}
+	if _, err := lookupEncoder("ebcdic"); err == nil {
+		t.Errorf("expected an error for an unsupported encoding")
+	}
+}
+
+func TestEncoders(t *testing.T) {
+	tt := []struct {
+		name     string
+		in       string
+		expected []byte
+	}{
+		{"utf-16", "aä", []byte{0xff, 0xfe, 'a', 0, 0xe4, 0}},
+		{"utf-16le", "a€", []byte{'a', 0, 0xac, 0x20}},
+		{"utf-16be", "a€", []byte{0, 'a', 0x20, 0xac}},
+		{"iso-8859-1", "aä", []byte{'a', 0xe4}},
+		{"windows-1252", "ä€", []byte{0xe4, 0x80}},
+	}
+	for _, tc := range tt {
+		enc, err := lookupEncoder(tc.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := enc([]byte(tc.in))
+		if err != nil {
+			t.Errorf("%s: %s", tc.name, err)
+		} else if !bytes.Equal(out, tc.expected) {
+			t.Errorf("%s: expected %x, got %x", tc.name, tc.expected, out)
+		}
+	}
+
+	enc, _ := lookupEncoder("iso-8859-1")
+	if _, err := enc([]byte("a: b\nc: €")); err == nil || err.Error() != `character '€' in line 2 can't be encoded in ISO-8859-1` {
+		t.Errorf("expected an error for a character not in ISO-8859-1, got %v", err)
+	}
+}
+
+func TestLookupEncoderNames(t *testing.T) {
+	tt := []struct {
+		name     string
+		in       string
+		expected []byte
+	}{
+		{"latin1", "ä", []byte{0xe4}},
+		{"Shift_JIS", "日本", []byte{0x93, 0xfa, 0x96, 0x7b}},
+		{"cp1252", "€", []byte{0x80}},
+	}
+	for _, tc := range tt {
+		enc, err := lookupEncoder(tc.name)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.name, err)
+		}
+		out, err := enc([]byte(tc.in))
+		if err != nil {
+			t.Errorf("%s: %s", tc.name, err)
+		} else if !bytes.Equal(out, tc.expected) {
+			t.Errorf("%s: expected %x, got %x", tc.name, tc.expected, out)
+		}
+	}
+
+	// ISO-8859-1 isn't treated like windows-1252, as browsers do.
+	enc, _ := lookupEncoder("latin1")
+	if _, err := enc([]byte("€")); err == nil || err.Error() != `character '€' in line 1 can't be encoded in ISO-8859-1` {
+		t.Errorf("expected an error for a character not in ISO-8859-1, got %v", err)
+	}
+}
+
+func TestTargetEncodingTwice(t *testing.T) {
+	target := getBaseTarget()
+	encoding := "UTF-16LE"
+	target.Params.Encoding = &encoding
+	if err := target.checkEncoding(); err != nil {
+		t.Errorf("expected params.encoding alone to be valid, got %s", err)
+	}
+
+	target.Encoding = "ISO-8859-1"
+	if err := target.checkEncoding(); err == nil || !strings.Contains(err.Error(), "encoded twice") {
+		t.Errorf("expected an error for encoding and params.encoding, got %v", err)
+	}
+}
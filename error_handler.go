@@ -10,10 +10,32 @@ import (
 	"os"
 	"runtime"
 	"runtime/debug"
+	"strings"
 )
 
 const errorsEndpoint = "https://phraseapp.com/errors"
 
+// DisableErrorReporting stops ReportError from sending errors to the
+// ErrorReporter.
+var DisableErrorReporting bool
+
+// PrettyErrors prints validation errors of the API as list of the invalid
+// fields.
+var PrettyErrors bool
+
+// An ErrorReporter receives the errors passed to ReportError.
+type ErrorReporter interface {
+	Report(name string, r interface{}, cfg *phraseapp.Config)
+}
+
+var errorReporter ErrorReporter = httpErrorReporter{}
+
+// SetErrorReporter replaces the reporter crashes are sent to, by default
+// they're posted to errorsEndpoint.
+func SetErrorReporter(reporter ErrorReporter) {
+	errorReporter = reporter
+}
+
 type AppCrash struct {
 	App        string `json:"app"`
 	AppVersion string `json:"app_version"`
@@ -44,7 +66,28 @@ func identification(cfg *phraseapp.Config) (string, string) {
 	return shortToken, projectID
 }
 
+// ReportError appends the error to the --report-file if given and hands it to
+// the configured ErrorReporter unless error reporting is disabled. Access
+// tokens are redacted from the message in both cases.
 func ReportError(name string, r interface{}, cfg *phraseapp.Config) {
+	if cfg != nil && cfg.Credentials != nil {
+		addSecret(cfg.Token)
+	}
+	message := redact(fmt.Sprintf("%s", r))
+	if ReportFile != "" {
+		writeReport(name, message)
+	}
+	if DisableErrorReporting || errorReporter == nil {
+		return
+	}
+	errorReporter.Report(name, message, cfg)
+}
+
+// httpErrorReporter posts the error to errorsEndpoint. See createBody for the
+// data sent.
+type httpErrorReporter struct{}
+
+func (httpErrorReporter) Report(name string, r interface{}, cfg *phraseapp.Config) {
 	message := fmt.Sprintf("%s", r)
 
 	shortToken, projectID := identification(cfg)
@@ -70,7 +113,7 @@ func createBody(name, message, shortToken, projectID string) ([]byte, error) {
 			ProjectID:        projectID,
 			Arch:             runtime.GOARCH,
 			Os:               runtime.GOOS,
-			Stack:            string(debug.Stack()),
+			Stack:            redact(string(debug.Stack())),
 			ClientInfo:       GetInfo(),
 		},
 		Name:    name,
@@ -82,6 +125,46 @@ func createBody(name, message, shortToken, projectID string) ([]byte, error) {
 
 func printErr(err error) {
 	ct.Foreground(ct.Red, true)
-	fmt.Fprintf(os.Stderr, "\nERROR: %s\n", err)
+	fmt.Fprintf(os.Stderr, "\nERROR: %s\n", redact(formatErr(err)))
 	ct.ResetColor()
 }
+
+// formatErr returns the message of err, with --pretty-errors validation
+// errors are formatted by formatValidationError.
+func formatErr(err error) string {
+	if PrettyErrors {
+		if verr := validationError(err); verr != nil {
+			return formatValidationError(verr)
+		}
+	}
+	return err.Error()
+}
+
+// validationError returns the validation error of the API err is or wraps,
+// nil if there is none.
+func validationError(err error) *phraseapp.ValidationErrorResponse {
+	switch e := err.(type) {
+	case *phraseapp.ValidationErrorResponse:
+		return e
+	case *invalidError:
+		return validationError(e.err)
+	case *partialError:
+		return validationError(e.err)
+	}
+	return nil
+}
+
+// formatValidationError lists the invalid fields with their messages below
+// the message of the API, e.g. "Validation failed" followed by
+// "  - name: has already been taken".
+func formatValidationError(err *phraseapp.ValidationErrorResponse) string {
+	lines := []string{err.Message}
+	for _, msg := range err.Errors {
+		field := msg.Field
+		if field == "" {
+			field = msg.Resource
+		}
+		lines = append(lines, fmt.Sprintf("  - %s: %s", field, msg.Message))
+	}
+	return strings.Join(lines, "\n")
+}
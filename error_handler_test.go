@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+type recordingReporter struct {
+	names []string
+}
+
+func (r *recordingReporter) Report(name string, _ interface{}, _ *phraseapp.Config) {
+	r.names = append(r.names, name)
+}
+
+func TestReportError(t *testing.T) {
+	defer SetErrorReporter(errorReporter)
+	defer func() { DisableErrorReporting = false }()
+
+	reporter := &recordingReporter{}
+	SetErrorReporter(reporter)
+
+	ReportError("Some Error", "boom", nil)
+	if len(reporter.names) != 1 || reporter.names[0] != "Some Error" {
+		t.Errorf("expected the error to be reported, got %v", reporter.names)
+	}
+
+	DisableErrorReporting = true
+	ReportError("Other Error", "boom", nil)
+	if len(reporter.names) != 1 {
+		t.Errorf("expected no report with error reporting disabled, got %v", reporter.names)
+	}
+}
+
+func TestFormatErr(t *testing.T) {
+	defer func() { PrettyErrors = false }()
+
+	err := &phraseapp.ValidationErrorResponse{
+		ErrorResponse: phraseapp.ErrorResponse{Message: "Validation failed"},
+		Errors: []phraseapp.ValidationErrorMessage{
+			{Resource: "key", Field: "name", Message: "has already been taken"},
+			{Resource: "key", Message: "is invalid"},
+		},
+	}
+	if got := formatErr(err); got != err.Error() {
+		t.Errorf("expected validation errors to be printed as they are without --pretty-errors, got %q", got)
+	}
+
+	PrettyErrors = true
+	expected := "Validation failed\n  - name: has already been taken\n  - key: is invalid"
+	for _, wrapped := range []error{err, &partialError{err}} {
+		if got := formatErr(wrapped); got != expected {
+			t.Errorf("expected %q, got %q", expected, got)
+		}
+	}
+	if got := formatErr(fmt.Errorf("404 - Resource Not Found")); got != "404 - Resource Not Found" {
+		t.Errorf("expected other errors to be unchanged, got %q", got)
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"net"
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// Exit codes of the client, so scripts can tell the kind of a failure apart
+// without parsing stderr.
+const (
+	exitOK      = 0
+	exitError   = 1   // any error not covered below
+	exitAuth    = 2   // invalid credentials or missing access token scope
+	exitNetwork = 3   // the API couldn't be reached or timed out
+	exitInvalid = 4   // invalid configuration, options or request parameters
+	exitPartial = 5   // pull or push failed after transferring some files
+	exitAborted = 130 // interrupted with Ctrl-C, like shells report SIGINT
+)
+
+// authError is returned for credentials known to be unusable before the
+// API is asked.
+type authError struct {
+	msg string
+}
+
+func (e *authError) Error() string {
+	return e.msg
+}
+
+// invalidError wraps errors in the configuration or options.
+type invalidError struct {
+	err error
+}
+
+func (e *invalidError) Error() string {
+	return e.err.Error()
+}
+
+// partialError is returned by pull and push if files were already transferred
+// when err happened.
+type partialError struct {
+	err error
+}
+
+func (e *partialError) Error() string {
+	return e.err.Error()
+}
+
+// exitCode returns the exit code for err.
+func exitCode(err error) int {
+	switch e := err.(type) {
+	case nil:
+		return exitOK
+	case *authError:
+		return exitAuth
+	case *invalidError, *phraseapp.ErrorResponse, *phraseapp.ValidationErrorResponse:
+		return exitInvalid
+	case *partialError:
+		return exitPartial
+	case *MultiError:
+		if e.Partial() {
+			return exitPartial
+		}
+		return exitCode(e.Errors[0].err)
+	case net.Error:
+		return exitNetwork
+	}
+
+	// The API client reports authentication failures as plain errors starting
+	// with the status code.
+	msg := err.Error()
+	if strings.HasPrefix(msg, "401 - ") || strings.HasPrefix(msg, "403 - ") {
+		return exitAuth
+	}
+	return exitError
+}
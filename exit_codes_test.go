@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestExitCode(t *testing.T) {
+	for _, tc := range []struct {
+		err      error
+		expected int
+	}{
+		{nil, exitOK},
+		{fmt.Errorf("something failed"), exitError},
+		{fmt.Errorf("401 - Unauthorized\nThe credentials you provided are invalid."), exitAuth},
+		{fmt.Errorf("403 - Forbidden for target config/locales/<locale_code>.yml"), exitAuth},
+		{&authError{"access token ...12345678 has no write scope"}, exitAuth},
+		{&net.OpError{Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused")}, exitNetwork},
+		{&invalidError{fmt.Errorf("invalid value for --since")}, exitInvalid},
+		{&phraseapp.ValidationErrorResponse{}, exitInvalid},
+		{&partialError{fmt.Errorf("404 - Resource Not Found")}, exitPartial},
+		{&MultiError{Total: 2, Errors: []*fileError{{path: "de.yml", err: fmt.Errorf("500 - Internal Server Error")}}}, exitPartial},
+		{&MultiError{Total: 1, Errors: []*fileError{{path: "de.yml", err: fmt.Errorf("401 - Unauthorized")}}}, exitAuth},
+	} {
+		if got := exitCode(tc.err); got != tc.expected {
+			t.Errorf("%v: expected exit code %d, got %d", tc.err, tc.expected, got)
+		}
+	}
+}
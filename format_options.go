@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// knownFormatOptions lists the format options supported per file format. Only
+// formats listed here are validated, options of other formats are passed on
+// unchecked.
+var knownFormatOptions = map[string][]string{
+	"csv": {
+		"column_separator", "quote_char", "header_content_row", "first_content_row",
+		"key_index", "comment_index", "tag_column", "max_characters_allowed_column",
+		"export_tags", "export_max_characters_allowed",
+	},
+	"xlsx": {
+		"header_content_row", "first_content_row", "key_index", "comment_index",
+		"tag_column", "max_characters_allowed_column", "export_tags",
+		"export_max_characters_allowed",
+	},
+	"properties": {
+		"escape_single_quotes", "omit_separator_space", "crlf_line_terminators",
+		"escape_meta_chars",
+	},
+	"xlf": {
+		"enclose_in_cdata", "include_translation_state", "override_file_language",
+	},
+}
+
+// unknownFormatOptions returns the sorted keys of options not supported by the
+// given format.
+func unknownFormatOptions(format string, options map[string]string) []string {
+	known, found := knownFormatOptions[format]
+	if !found {
+		return nil
+	}
+
+	unknown := []string{}
+	for key := range options {
+		if !Contains(known, key) {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// rewriteFormatOptionArgs turns every --format-option key=value into the
+// --format-options.key value syntax the router uses for map options, so format
+// options can be given one per --format-option. Arguments following "--" are
+// left untouched.
+func rewriteFormatOptionArgs(args []string) ([]string, error) {
+	res := []string{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return append(res, args[i:]...), nil
+		}
+
+		var value string
+		switch {
+		case arg == "--format-option":
+			if i+1 >= len(args) {
+				return nil, fmt.Errorf("missing value for option --format-option")
+			}
+			i++
+			value = args[i]
+		case strings.HasPrefix(arg, "--format-option="):
+			value = strings.TrimPrefix(arg, "--format-option=")
+		default:
+			res = append(res, arg)
+			continue
+		}
+
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid value %q for --format-option, expected key=value", value)
+		}
+		res = append(res, "--format-options."+parts[0], parts[1])
+	}
+	return res, nil
+}
+
+// defaultFormatOptions returns the format_options of the locale/download
+// defaults, which pull targets inherit.
+func defaultFormatOptions(cfg *phraseapp.Config) (map[string]string, error) {
+	value, found := cfg.Defaults["locale/download"]["format_options"]
+	if !found {
+		return nil, nil
+	}
+	params := new(phraseapp.LocaleDownloadParams)
+	if err := params.ApplyValuesFromMap(map[string]interface{}{"format_options": value}); err != nil {
+		return nil, fmt.Errorf("invalid defaults of locale/download: %s", err)
+	}
+	return params.FormatOptions, nil
+}
+
+// mergeFormatOptions returns the defaults with options added, options win if
+// both set a key. Returns options unchanged if there are no defaults.
+func mergeFormatOptions(defaults, options map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return options
+	}
+	merged := map[string]string{}
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range options {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRewriteFormatOptionArgs(t *testing.T) {
+	args, err := rewriteFormatOptionArgs([]string{
+		"locale/download", "--format-option", "include_tags=true", "--format-option=column_separator=,", "--", "--format-option", "x",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"locale/download", "--format-options.include_tags", "true", "--format-options.column_separator", ",", "--", "--format-option", "x",
+	}
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("expected args %v, got %v", expected, args)
+	}
+
+	for _, args := range [][]string{{"--format-option"}, {"--format-option", "include_tags"}, {"--format-option==true"}} {
+		if _, err := rewriteFormatOptionArgs(args); err == nil {
+			t.Errorf("expected an error for %v", args)
+		}
+	}
+}
+
+func TestTargetFormatOptionsMerge(t *testing.T) {
+	cfg, err := ParseConfig([]byte(`phraseapp:
+  access_token: some_token
+  project_id: project-id
+  defaults:
+    locale/download:
+      format_options:
+        escape_single_quotes: true
+        include_tags: false
+  pull:
+    targets:
+    - file: ./<locale_code>.csv
+      params:
+        format_options:
+          include_tags: true
+          column_separator: ";"
+    - file: ./<locale_code>.yml
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	targets, err := TargetsFromConfig(&PullCommand{Config: cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{"escape_single_quotes": "true", "include_tags": "true", "column_separator": ";"}
+	if got := targets[0].Params.FormatOptions; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected the format options to be merged with the target's winning, got %v", got)
+	}
+	expected = map[string]string{"escape_single_quotes": "true", "include_tags": "false"}
+	if got := targets[1].Params.FormatOptions; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected the default format options, got %v", got)
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/gopkg.in/yaml.v2"
+)
+
+// GitignoreCommand prints the files written by pull as .gitignore patterns,
+// for projects not committing their locale files.
+type GitignoreCommand struct {
+	*phraseapp.Config
+
+	Append bool   `cli:"opt --append desc='add the patterns missing in .gitignore next to the configuration file instead of printing them'"`
+	OutDir string `cli:"opt --out-dir desc='directory relative file patterns of targets are resolved in, as for pull (also available as out_dir configuration key)'"`
+}
+
+func (cmd *GitignoreCommand) Run() error {
+	outDir := targetOutDir(cmd.OutDir)
+
+	dir := configDir()
+	patterns, err := gitignorePatterns(cmd.Config.Targets, outDir, dir)
+	if err != nil {
+		return &invalidError{err}
+	}
+
+	if !cmd.Append {
+		for _, pattern := range patterns {
+			fmt.Println(pattern)
+		}
+		return nil
+	}
+
+	path := filepath.Join(dir, ".gitignore")
+	added, err := appendGitignore(path, patterns)
+	if err != nil {
+		return err
+	}
+	if !Quiet {
+		fmt.Fprintf(os.Stderr, "Added %d of %d patterns to %s\n", added, len(patterns), path)
+	}
+	return nil
+}
+
+// gitignorePatterns returns the files of the pull targets in rawTargets as
+// patterns for a .gitignore in dir, with placeholders replaced by *. Relative
+// files are resolved like pull does, in outDir if given. Targets outside of
+// dir are skipped, they can't be ignored there.
+func gitignorePatterns(rawTargets []byte, outDir, dir string) ([]string, error) {
+	tmp := struct {
+		Targets Targets
+	}{}
+	if err := yaml.Unmarshal(rawTargets, &tmp); err != nil {
+		return nil, err
+	}
+	if len(tmp.Targets) == 0 {
+		return nil, fmt.Errorf("no targets for download specified%s", configHint())
+	}
+
+	patterns := []string{}
+	seen := map[string]bool{}
+	for _, target := range tmp.Targets {
+		if target == nil || target.IsStdout() {
+			continue
+		}
+
+		file := target.File
+		switch {
+		case filepath.IsAbs(file):
+		case outDir != "":
+			file = filepath.Join(outDir, file)
+		default:
+			file = resolvePattern(file)
+		}
+		file, err := filepath.Abs(file)
+		if err != nil {
+			return nil, err
+		}
+
+		rel, err := filepath.Rel(dir, file)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			logger.Warnf("skipped target %s, it's outside of %s", target.File, dir)
+			continue
+		}
+
+		pattern := "/" + filepath.ToSlash(gitignoreGlob(rel))
+		if !seen[pattern] {
+			seen[pattern] = true
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns, nil
+}
+
+// gitignoreGlob replaces the placeholders of a target file with *. <branch>
+// is replaced by the branch if one is given.
+func gitignoreGlob(file string) string {
+	if Branch != "" {
+		file = strings.Replace(file, "<branch>", Branch, -1)
+	}
+	file = strings.Replace(file, "<branch>", "*", -1)
+	return placeholderRegexp.ReplaceAllString(file, "*")
+}
+
+// appendGitignore adds the patterns not yet contained in the .gitignore at
+// path, which is created if missing. Returns the number of added patterns.
+func appendGitignore(path string, patterns []string) (int, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	existing := map[string]bool{}
+	endsWithNewline := true
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		existing[strings.TrimSpace(scanner.Text())] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	if info, err := f.Stat(); err == nil && info.Size() > 0 {
+		last := make([]byte, 1)
+		if _, err := f.ReadAt(last, info.Size()-1); err == nil {
+			endsWithNewline = last[0] == '\n'
+		}
+	}
+
+	missing := []string{}
+	for _, pattern := range patterns {
+		if !existing[pattern] {
+			missing = append(missing, pattern)
+		}
+	}
+	if len(missing) == 0 {
+		return 0, nil
+	}
+
+	if _, err := f.Seek(0, io.SeekEnd); err != nil {
+		return 0, err
+	}
+	content := strings.Join(missing, "\n") + "\n"
+	if !endsWithNewline {
+		content = "\n" + content
+	}
+	if _, err := f.WriteString(content); err != nil {
+		return 0, err
+	}
+	return len(missing), f.Close()
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGitignorePatterns(t *testing.T) {
+	defer func() { Branch = "" }()
+
+	targets := []byte(`targets:
+- file: ./config/locales/<locale_code>.yml
+- file: ./config/locales/<locale_code>.yml
+  params:
+    tag: web
+- file: ./res/values-<locale_name>/<tag>.xml
+- file: ./<branch>/<locale_code>.json
+- file: "-"
+- file: ../shared/<locale_code>.yml
+`)
+	dir := workingDir()
+
+	patterns, err := gitignorePatterns(targets, "", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"/config/locales/*.yml", "/res/values-*/*.xml", "/*/*.json"}
+	if !reflect.DeepEqual(patterns, expected) {
+		t.Errorf("expected %v, got %v", expected, patterns)
+	}
+
+	Branch = "feature"
+	patterns, err = gitignorePatterns(targets, filepath.Join(dir, "build"), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []string{"/build/config/locales/*.yml", "/build/res/values-*/*.xml", "/build/feature/*.json", "/shared/*.yml"}
+	if !reflect.DeepEqual(patterns, expected) {
+		t.Errorf("expected the patterns in the out dir, got %v", patterns)
+	}
+}
+
+func TestAppendGitignore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp-gitignore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, ".gitignore")
+	if err := ioutil.WriteFile(path, []byte("/vendor\n/config/locales/*.yml"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	patterns := []string{"/config/locales/*.yml", "/res/values-*/*.xml"}
+	for i, expected := range []int{1, 0} {
+		added, err := appendGitignore(path, patterns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if added != expected {
+			t.Errorf("run %d: expected %d added patterns, got %d", i+1, expected, added)
+		}
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "/vendor\n/config/locales/*.yml\n/res/values-*/*.xml\n"; string(content) != expected {
+		t.Errorf("expected %q, got %q", expected, content)
+	}
+}
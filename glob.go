@@ -0,0 +1,124 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+// expandBraces expands brace groups in pattern like a shell does, so
+// "locales/{en,de}.json" results in "locales/en.json" and "locales/de.json".
+// Groups can be nested. Braces without a matching closing brace or without a
+// comma are kept as they are.
+func expandBraces(pattern string) []string {
+	open := -1
+	depth := 0
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '{':
+			if depth == 0 {
+				open = i
+			}
+			depth++
+		case '}':
+			if depth == 0 {
+				continue
+			}
+			depth--
+			if depth > 0 {
+				continue
+			}
+			alternatives := splitBraceGroup(pattern[open+1 : i])
+			if len(alternatives) < 2 {
+				// Not a group, keep the braces and look for groups after it.
+				rest := expandBraces(pattern[open+1:])
+				expanded := make([]string, len(rest))
+				for j, r := range rest {
+					expanded[j] = pattern[:open+1] + r
+				}
+				return expanded
+			}
+
+			expanded := []string{}
+			for _, suffix := range expandBraces(pattern[i+1:]) {
+				for _, alternative := range alternatives {
+					for _, a := range expandBraces(alternative) {
+						expanded = append(expanded, pattern[:open]+a+suffix)
+					}
+				}
+			}
+			return expanded
+		}
+	}
+
+	if depth > 0 {
+		// The group opened last isn't closed, there might be groups in it.
+		rest := expandBraces(pattern[open+1:])
+		expanded := make([]string, len(rest))
+		for j, r := range rest {
+			expanded[j] = pattern[:open+1] + r
+		}
+		return expanded
+	}
+	return []string{pattern}
+}
+
+// splitBraceGroup splits the content of a brace group at the commas not
+// nested in another group.
+func splitBraceGroup(group string) []string {
+	parts := []string{}
+	depth, start := 0, 0
+	for i := 0; i < len(group); i++ {
+		switch group[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, group[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, group[start:])
+}
+
+// hasGlobMeta reports whether the path segment contains glob characters.
+func hasGlobMeta(segment string) bool {
+	return strings.ContainsAny(segment, "*?[")
+}
+
+// globSegmentRegexp converts the glob pattern of a path segment to a regular
+// expression. A star matches any characters, a question mark a single one and
+// character classes like [a-z] or [!0-9] a single one of the class. Like the
+// star always did, the expression matches segments continuing after the
+// pattern, e.g. *.yml matches "en.yml.orig".
+func globSegmentRegexp(segment string) (*regexp.Regexp, error) {
+	expr := "^"
+	for i := 0; i < len(segment); i++ {
+		switch segment[i] {
+		case '*':
+			expr += ".*"
+		case '?':
+			expr += "."
+		case '[':
+			end := strings.IndexByte(segment[i+1:], ']')
+			if end < 0 {
+				expr += regexp.QuoteMeta(segment[i:])
+				i = len(segment)
+				continue
+			}
+			class := segment[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr += "[" + strings.Replace(class, `\`, `\\`, -1) + "]"
+			i += end + 1
+		default:
+			expr += regexp.QuoteMeta(segment[i : i+1])
+		}
+	}
+	return regexp.Compile(expr)
+}
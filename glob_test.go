@@ -0,0 +1,111 @@
+package main
+
+import (
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestExpandBraces(t *testing.T) {
+	tt := []struct {
+		pattern  string
+		expected []string
+	}{
+		{"locales/en.json", []string{"locales/en.json"}},
+		{"locales/{en,de,fr}.json", []string{"locales/en.json", "locales/de.json", "locales/fr.json"}},
+		{"{app,lib}/{en,de}.yml", []string{"app/en.yml", "lib/en.yml", "app/de.yml", "lib/de.yml"}},
+		{"locales/{en,de{,-AT}}.json", []string{"locales/en.json", "locales/de.json", "locales/de-AT.json"}},
+		{"a/{x}/{en,de}.json", []string{"a/{x}/en.json", "a/{x}/de.json"}},
+		{"./abc+/defg./}{x/][etc??/<locale_code>.yml", []string{"./abc+/defg./}{x/][etc??/<locale_code>.yml"}},
+		{"a/{b/{en,de}.json", []string{"a/{b/en.json", "a/{b/de.json"}},
+	}
+	for _, tc := range tt {
+		got := expandBraces(tc.pattern)
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("%s: expected %q, got %q", tc.pattern, tc.expected, got)
+		}
+	}
+}
+
+func TestGlobSegmentRegexp(t *testing.T) {
+	tt := []struct {
+		glob, segment string
+		match         bool
+	}{
+		{"*.json", "en.json", true},
+		{"*.json", "en.yml", false},
+		{"??.json", "en.json", true},
+		{"??.json", "e.json", false},
+		{"[a-f]*.json", "de.json", true},
+		{"[a-f]*.json", "fr.json", true},
+		{"[a-f]*.json", "it.json", false},
+		{"[!a-f]*.json", "it.json", true},
+		{"en.*", "xen.yml", false},
+		{"a+b[.yml", "a+b[.yml", true},
+	}
+	for _, tc := range tt {
+		expr, err := globSegmentRegexp(tc.glob)
+		if err != nil {
+			t.Errorf("%s: %s", tc.glob, err)
+			continue
+		}
+		if match := expr.MatchString(tc.segment); match != tc.match {
+			t.Errorf("%s: expected match of %q to be %t", tc.glob, tc.segment, tc.match)
+		}
+	}
+}
+
+func TestSystemFilesGlobs(t *testing.T) {
+	d := setupFiles(t,
+		"locales/en.json",
+		"locales/de.json",
+		"locales/fr.json",
+		"app/a/locales/en.yml",
+		"app/a/b/locales/de.yml",
+		"app/a/b/locales/it.yml",
+		"lib/locales/en.yml",
+	)
+	defer os.RemoveAll(d)
+	defer pushd(t, d)()
+
+	tt := []struct {
+		pattern  string
+		expected []string
+	}{
+		{"locales/{en,de}.json", []string{"locales/de.json", "locales/en.json"}},
+		{"locales/[d-f]?.json", []string{"locales/de.json", "locales/en.json", "locales/fr.json"}},
+		{"app/**/locales/[a-e]*.yml", []string{"app/a/b/locales/de.yml", "app/a/locales/en.yml"}},
+		{"{app,lib}/**/locales/<locale_code>.yml", []string{"app/a/b/locales/de.yml", "app/a/b/locales/it.yml", "app/a/locales/en.yml", "lib/locales/en.yml"}},
+		{"locales/{en,en}.json", []string{"locales/en.json"}},
+	}
+	for _, tc := range tt {
+		src := &Source{File: tc.pattern}
+		if err := src.CheckPreconditions(); err != nil {
+			t.Errorf("%s: %s", tc.pattern, err)
+			continue
+		}
+		files, err := src.SystemFiles()
+		if err != nil {
+			t.Errorf("%s: %s", tc.pattern, err)
+			continue
+		}
+		sort.Strings(files)
+		if !reflect.DeepEqual(files, tc.expected) {
+			t.Errorf("%s: expected %q, got %q", tc.pattern, tc.expected, files)
+		}
+	}
+
+	src := &Source{File: "{app/a,lib}/locales/<locale_code>.yml"}
+	localeFiles, err := src.LocaleFiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes := []string{}
+	for _, localeFile := range localeFiles {
+		codes = append(codes, localeFile.Code)
+	}
+	if !reflect.DeepEqual(codes, []string{"en", "en"}) {
+		t.Errorf("expected the locale codes to be read with the expanded patterns, got %q", codes)
+	}
+}
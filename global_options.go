@@ -0,0 +1,179 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Quiet suppresses informational output. Errors and data are still printed.
+var Quiet bool
+
+// A globalOption is accepted by every command. Global options are removed from
+// the arguments before the remaining ones are handed to the router, as the
+// command structs are generated and don't know about them.
+type globalOption struct {
+	name   string
+	isFlag bool
+	desc   string
+	apply  func(value string) error
+}
+
+var globalOptions = []*globalOption{
+	{name: "quiet", isFlag: true, desc: "suppress informational output", apply: func(string) error {
+		Quiet = true
+		return nil
+	}},
+	{name: "pretty", isFlag: true, desc: "indent JSON output, colorized on a terminal unless NO_COLOR is set", apply: func(string) error {
+		Pretty = true
+		return nil
+	}},
+	{name: "summary-only", isFlag: true, desc: "print a few fields of resources as name: value lines instead of JSON", apply: func(string) error {
+		SummaryOnly = true
+		return nil
+	}},
+	{name: "no-report", isFlag: true, desc: "don't send crash reports to PhraseApp", apply: func(string) error {
+		DisableErrorReporting = true
+		return nil
+	}},
+	{name: "report-file", desc: "append crash reports as JSON lines to the given file", apply: func(value string) error {
+		ReportFile = value
+		return nil
+	}},
+	{name: "pretty-errors", isFlag: true, desc: "print validation errors of the API as list of the invalid fields", apply: func(string) error {
+		PrettyErrors = true
+		return nil
+	}},
+	{name: "strict", isFlag: true, desc: "fail on warnings, e.g. about patterns matching nothing or unsupported format options", apply: func(string) error {
+		Strict = true
+		return nil
+	}},
+	{name: "yes", isFlag: true, desc: "don't ask for confirmation before deleting data", apply: func(string) error {
+		AssumeYes = true
+		return nil
+	}},
+	{name: "config", desc: "configuration file to use instead of .phraseapp.yml", apply: func(value string) error {
+		ConfigFile = value
+		return nil
+	}},
+	{name: "config-inline", desc: "configuration as YAML, taking precedence over configuration files", apply: func(value string) error {
+		ConfigInline = value
+		return nil
+	}},
+	{name: "base-dir", desc: "directory relative file patterns of sources and targets are resolved against, instead of the one of the configuration file", apply: func(value string) error {
+		BaseDir = value
+		return nil
+	}},
+	{name: "env", desc: "environment of the configuration to merge over the other settings, e.g. staging", apply: func(value string) error {
+		Environment = value
+		return nil
+	}},
+	{name: "stdin-yaml", isFlag: true, desc: "read YAML from stdin overriding defaults of commands and params of pull targets and push sources", apply: func(string) error {
+		StdinYAML = true
+		return nil
+	}},
+	{name: "project-id", desc: "project to use instead of the configured ones", apply: func(value string) error {
+		ProjectIDOverride = value
+		return nil
+	}},
+	{name: "branch", desc: "branch to send all requests for", apply: func(value string) error {
+		Branch = value
+		return nil
+	}},
+	{name: "user-agent", desc: "text appended to the User-Agent header of all requests", apply: func(value string) error {
+		UserAgent = value
+		return nil
+	}},
+	{name: "header", desc: "header added to all requests as key=value, can be repeated", apply: addHeader},
+	{name: "allow-authorization-header", isFlag: true, desc: "allow --header to replace the Authorization header carrying the access token", apply: func(string) error {
+		AllowAuthorizationHeader = true
+		return nil
+	}},
+	{name: "rps", desc: "send at most the given number of requests per second, e.g. 2 or 0.5", apply: func(value string) error {
+		limiter, err := parseRPS(value)
+		if err != nil {
+			return err
+		}
+		requestLimiter = limiter
+		return nil
+	}},
+	{name: "timeout", desc: "abort the command after the given duration, e.g. 5m", apply: func(value string) error {
+		timeout, err := parseTimeout(value)
+		if err != nil {
+			return err
+		}
+		Timeout = timeout
+		return nil
+	}},
+	{name: "log-level", desc: "log messages up to the given level to stderr: error, warn, info (default) or debug", apply: func(value string) error {
+		level, err := parseLogLevel(value)
+		if err != nil {
+			return err
+		}
+		logger.level = level
+		return nil
+	}},
+}
+
+func findGlobalOption(name string) *globalOption {
+	for _, opt := range globalOptions {
+		if opt.name == name {
+			return opt
+		}
+	}
+	return nil
+}
+
+// extractGlobalOptions applies all global options found in args and returns
+// the remaining arguments. Arguments following "--" are left untouched.
+func extractGlobalOptions(args []string) ([]string, error) {
+	remaining := []string{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return append(remaining, args[i:]...), nil
+		}
+		if !strings.HasPrefix(arg, "--") {
+			remaining = append(remaining, arg)
+			continue
+		}
+
+		name, value, hasValue := arg[2:], "", false
+		if parts := strings.SplitN(name, "=", 2); len(parts) == 2 {
+			name, value, hasValue = parts[0], parts[1], true
+		}
+
+		opt := findGlobalOption(name)
+		if opt == nil {
+			remaining = append(remaining, arg)
+			continue
+		}
+
+		switch {
+		case opt.isFlag && hasValue:
+			return nil, fmt.Errorf("option --%s doesn't take a value", opt.name)
+		case !opt.isFlag && !hasValue:
+			if i+1 >= len(args) {
+				return nil, fmt.Errorf("missing value for option --%s", opt.name)
+			}
+			i++
+			value = args[i]
+		}
+
+		if err := opt.apply(value); err != nil {
+			return nil, fmt.Errorf("invalid value for option --%s: %s", opt.name, err)
+		}
+	}
+	return remaining, nil
+}
+
+func globalOptionsHelp() string {
+	lines := []string{"GLOBAL OPTIONS"}
+	for _, opt := range globalOptions {
+		name := "--" + opt.name
+		if !opt.isFlag {
+			name += " <value>"
+		}
+		lines = append(lines, fmt.Sprintf("    %-26s%s", name, opt.desc))
+	}
+	return strings.Join(lines, "\n")
+}
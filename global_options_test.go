@@ -0,0 +1,44 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractGlobalOptions(t *testing.T) {
+	defer func() { Quiet = false }()
+
+	args, err := extractGlobalOptions([]string{"pull", "--quiet", "--verbose"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Quiet {
+		t.Errorf("expected --quiet to be applied")
+	}
+	if expected := []string{"pull", "--verbose"}; !reflect.DeepEqual(args, expected) {
+		t.Errorf("expected remaining args to be %v, got %v", expected, args)
+	}
+}
+
+func TestExtractGlobalOptionsStopsAtDoubleDash(t *testing.T) {
+	defer func() { Quiet = false }()
+
+	args, err := extractGlobalOptions([]string{"key/create", "--", "--quiet"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Quiet {
+		t.Errorf("expected --quiet after -- not to be applied")
+	}
+	if expected := []string{"key/create", "--", "--quiet"}; !reflect.DeepEqual(args, expected) {
+		t.Errorf("expected remaining args to be %v, got %v", expected, args)
+	}
+}
+
+func TestExtractGlobalOptionsFlagWithValue(t *testing.T) {
+	defer func() { Quiet = false }()
+
+	if _, err := extractGlobalOptions([]string{"pull", "--quiet=yes"}); err == nil {
+		t.Errorf("expected an error for a flag given a value")
+	}
+}
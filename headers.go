@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Headers are added to all requests, set with the repeatable --header option,
+// e.g. for proxies requiring a token of their own.
+var Headers = http.Header{}
+
+// AllowAuthorizationHeader permits --header to replace the Authorization
+// header, which otherwise carries the access token.
+var AllowAuthorizationHeader bool
+
+// addHeader adds a header given as key=value to Headers.
+func addHeader(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("%q is no header like key=value", value)
+	}
+	key := strings.TrimSpace(parts[0])
+	if key == "" || strings.ContainsAny(key, " \t:") {
+		return fmt.Errorf("invalid header name %q", key)
+	}
+	Headers.Add(key, parts[1])
+	return nil
+}
+
+// checkHeaders fails if --header replaces the Authorization header without
+// --allow-authorization-header. It's called once all options are applied, as
+// they can come in any order.
+func checkHeaders() error {
+	if _, found := Headers[http.CanonicalHeaderKey("Authorization")]; found && !AllowAuthorizationHeader {
+		return fmt.Errorf("--header Authorization replaces the access token, pass --allow-authorization-header if that's intended")
+	}
+	return nil
+}
+
+// headerTransport adds Headers to all requests, replacing headers of the same
+// name.
+type headerTransport struct {
+	next   http.RoundTripper
+	header http.Header
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := new(http.Request)
+	*r = *req
+	r.Header = http.Header{}
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	for k, v := range t.header {
+		r.Header[k] = v
+	}
+	return t.next.RoundTrip(r)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHeaderOptions(t *testing.T) {
+	defer func() { Headers, AllowAuthorizationHeader = http.Header{}, false }()
+
+	args, err := extractGlobalOptions([]string{"projects", "list", "--header", "X-Proxy-Token=secret=1", "--header=X-Team=i18n"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(args) != 2 || Headers.Get("X-Proxy-Token") != "secret=1" || Headers.Get("X-Team") != "i18n" {
+		t.Errorf("expected both headers to be set, got %v (args %v)", Headers, args)
+	}
+	if _, err := extractGlobalOptions([]string{"--header", "X-Proxy-Token"}); err == nil {
+		t.Errorf("expected an error for a header without value")
+	}
+
+	if err := checkHeaders(); err != nil {
+		t.Errorf("expected custom headers to be allowed, got %s", err)
+	}
+	Headers.Set("Authorization", "Bearer other")
+	if err := checkHeaders(); err == nil {
+		t.Errorf("expected an error for replacing the Authorization header")
+	}
+	AllowAuthorizationHeader = true
+	if err := checkHeaders(); err != nil {
+		t.Errorf("expected --allow-authorization-header to permit it, got %s", err)
+	}
+}
+
+func TestHeaderTransport(t *testing.T) {
+	var received http.Header
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+	}))
+	defer s.Close()
+
+	header := http.Header{}
+	header.Set("X-Proxy-Token", "secret")
+	header.Set("Accept", "application/json")
+	c := http.Client{Transport: &headerTransport{next: http.DefaultTransport, header: header}}
+
+	req, err := http.NewRequest("GET", s.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept", "text/plain")
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if received.Get("X-Proxy-Token") != "secret" || received.Get("Accept") != "application/json" {
+		t.Errorf("expected the headers to be added, got %v", received)
+	}
+	if req.Header.Get("Accept") != "text/plain" {
+		t.Errorf("expected the original request to be unchanged")
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const ignoreFileName = ".phraseappignore"
+
+// IgnoreRules are the gitignore style patterns read from a .phraseappignore
+// file. Patterns are matched against paths relative to the directory the file
+// was found in.
+type IgnoreRules struct {
+	base  string
+	rules []*ignoreRule
+}
+
+type ignoreRule struct {
+	re       *regexp.Regexp
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// LoadIgnoreRules reads the .phraseappignore file in the given directory. A
+// missing file results in an empty rule set.
+func LoadIgnoreRules(dir string) (*IgnoreRules, error) {
+	rules := &IgnoreRules{base: dir}
+
+	f, err := os.Open(filepath.Join(dir, ignoreFileName))
+	if os.IsNotExist(err) {
+		return rules, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		rule, err := parseIgnoreRule(sc.Text())
+		if err != nil {
+			return nil, err
+		}
+		if rule != nil {
+			rules.rules = append(rules.rules, rule)
+		}
+	}
+	return rules, sc.Err()
+}
+
+func parseIgnoreRule(line string) (*ignoreRule, error) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil, nil
+	}
+
+	rule := &ignoreRule{}
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if strings.Contains(line, "/") {
+		rule.anchored = true
+		line = strings.TrimLeft(line, "/")
+	}
+
+	re, err := regexp.Compile("^" + ignorePatternToRegexp(line) + "$")
+	if err != nil {
+		return nil, err
+	}
+	rule.re = re
+	return rule, nil
+}
+
+func ignorePatternToRegexp(pattern string) string {
+	var expr string
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			expr += "(.*/)?"
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			expr += ".*"
+			i++
+		case c == '*':
+			expr += "[^/]*"
+		case c == '?':
+			expr += "[^/]"
+		case c == '[':
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				expr += regexp.QuoteMeta(string(c))
+				continue
+			}
+			class := pattern[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr += "[" + class + "]"
+			i += end
+		default:
+			expr += regexp.QuoteMeta(string(c))
+		}
+	}
+	return expr
+}
+
+// Ignored reports whether the given path is excluded by the rules. Like git,
+// a file inside an ignored directory can't be re-included.
+func (r *IgnoreRules) Ignored(path string) bool {
+	if r == nil || len(r.rules) == 0 {
+		return false
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(r.base, absPath)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return false
+	}
+
+	segments := strings.Split(filepath.ToSlash(rel), "/")
+	for i := range segments {
+		isDir := i < len(segments)-1
+		if r.matches(strings.Join(segments[:i+1], "/"), isDir) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *IgnoreRules) matches(rel string, isDir bool) bool {
+	ignored := false
+	for _, rule := range r.rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		candidate := rel
+		if !rule.anchored {
+			candidate = rel[strings.LastIndex(rel, "/")+1:]
+		}
+		if rule.re.MatchString(candidate) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+// Filter removes all ignored paths and returns the remaining ones together
+// with the number of skipped paths.
+func (r *IgnoreRules) Filter(paths []string) ([]string, int) {
+	kept := []string{}
+	for _, path := range paths {
+		if !r.Ignored(path) {
+			kept = append(kept, path)
+		}
+	}
+	return kept, len(paths) - len(kept)
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIgnoreRules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp_ignore_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	content := strings.Join([]string{
+		"# comment",
+		"*.bak",
+		"vendor/",
+		"/locales/tmp/*.yml",
+		"**/pseudo/*.yml",
+		"!keep.bak",
+	}, "\n")
+	if err := ioutil.WriteFile(filepath.Join(dir, ignoreFileName), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	rules, err := LoadIgnoreRules(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, expected := range map[string]bool{
+		"locales/en.yml":            false,
+		"locales/en.yml.bak":        true,
+		"locales/keep.bak":          false,
+		"vendor/locales/en.yml":     true,
+		"locales/vendor":            false,
+		"locales/tmp/en.yml":        true,
+		"other/locales/tmp/en.yml":  false,
+		"a/b/pseudo/en.yml":         true,
+		"pseudo/en.yml":             true,
+		"pseudo/nested/en.yml":      false,
+		"../outside/locales/en.bak": false,
+	} {
+		if got := rules.Ignored(filepath.Join(dir, path)); got != expected {
+			t.Errorf("%s: expected ignored to be %t, got %t", path, expected, got)
+		}
+	}
+
+	kept, skipped := rules.Filter([]string{
+		filepath.Join(dir, "locales/en.yml"),
+		filepath.Join(dir, "locales/de.yml.bak"),
+	})
+	if skipped != 1 || len(kept) != 1 {
+		t.Errorf("expected one file to be kept and one to be skipped, got %v (%d skipped)", kept, skipped)
+	}
+}
+
+func TestIgnoreRulesMissingFile(t *testing.T) {
+	rules, err := LoadIgnoreRules(os.TempDir() + "/does-not-exist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules.Ignored("en.yml") {
+		t.Errorf("expected nothing to be ignored without an ignore file")
+	}
+}
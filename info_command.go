@@ -23,11 +23,46 @@ func GetInfo() string {
 	return fmt.Sprintf("%s\n", strings.Join(info, "\n"))
 }
 
-func infoCommand() error {
+// InfoCommand prints the version and revisions of the client.
+type InfoCommand struct {
+	JSON bool `cli:"opt --json desc='print the info as JSON object'"`
+}
+
+func (cmd *InfoCommand) Run() error {
+	if cmd.JSON {
+		return printJSON(getInfoJSON())
+	}
 	fmt.Print(GetInfo())
 	return nil
 }
 
+// infoJSON is the machine readable form of GetInfo.
+type infoJSON struct {
+	Version           string `json:"version"`
+	Revision          string `json:"revision"`
+	LibraryRevision   string `json:"library_revision"`
+	DocsRevision      string `json:"docs_revision"`
+	GeneratorRevision string `json:"generator_revision"`
+	BuiltAt           string `json:"built_at"`
+	GoVersion         string `json:"go_version"`
+	OS                string `json:"os"`
+	Arch              string `json:"arch"`
+}
+
+func getInfoJSON() *infoJSON {
+	return &infoJSON{
+		Version:           PHRASEAPP_CLIENT_VERSION,
+		Revision:          REVISION,
+		LibraryRevision:   LIBRARY_REVISION,
+		DocsRevision:      RevisionDocs,
+		GeneratorRevision: RevisionGenerator,
+		BuiltAt:           BUILT_AT,
+		GoVersion:         runtime.Version(),
+		OS:                runtime.GOOS,
+		Arch:              runtime.GOARCH,
+	}
+}
+
 var (
 	REVISION                 string
 	LIBRARY_REVISION         string
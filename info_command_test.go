@@ -0,0 +1,27 @@
+package main
+
+import (
+	"encoding/json"
+	"runtime"
+	"testing"
+)
+
+func TestInfoJSON(t *testing.T) {
+	content, err := json.Marshal(getInfoJSON())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info := map[string]string{}
+	if err := json.Unmarshal(content, &info); err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range []string{"version", "revision", "docs_revision", "generator_revision", "go_version", "os", "arch"} {
+		if info[field] == "" {
+			t.Errorf("expected %s to be set, got %s", field, content)
+		}
+	}
+	if info["os"] != runtime.GOOS || info["docs_revision"] != RevisionDocs {
+		t.Errorf("unexpected info %s", content)
+	}
+}
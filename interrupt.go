@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+)
+
+// interrupted is closed once the user interrupts the client with Ctrl-C.
+var interrupted = make(chan struct{})
+
+// interruptGracePeriod is the time the command gets to return after an
+// interrupt before the client exits anyway, e.g. when waiting for input.
+const interruptGracePeriod = 5 * time.Second
+
+// handleInterrupts closes interrupted and calls cancel on the first Ctrl-C,
+// which aborts all requests in flight and ends watch mode. A second one, or
+// the command not returning within interruptGracePeriod, exits immediately.
+func handleInterrupts(cancel func()) {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt)
+
+	go func() {
+		<-signals
+		close(interrupted)
+		cancel()
+
+		select {
+		case <-signals:
+		case <-time.After(interruptGracePeriod):
+		}
+		exitInterrupted()
+	}()
+}
+
+func isInterrupted() bool {
+	select {
+	case <-interrupted:
+		return true
+	default:
+		return false
+	}
+}
+
+// exitInterrupted removes the temporary files being written and exits.
+func exitInterrupted() {
+	removeTempFiles()
+	printErr(fmt.Errorf("aborted by user"))
+	os.Exit(exitAborted)
+}
+
+var tempFiles = struct {
+	sync.Mutex
+	paths map[string]bool
+}{paths: map[string]bool{}}
+
+// trackTempFile registers a temporary file to be removed if the client is
+// interrupted before it's renamed or removed.
+func trackTempFile(path string) {
+	tempFiles.Lock()
+	defer tempFiles.Unlock()
+	tempFiles.paths[path] = true
+}
+
+func untrackTempFile(path string) {
+	tempFiles.Lock()
+	defer tempFiles.Unlock()
+	delete(tempFiles.paths, path)
+}
+
+func removeTempFiles() {
+	tempFiles.Lock()
+	defer tempFiles.Unlock()
+	for path := range tempFiles.paths {
+		os.Remove(path)
+		delete(tempFiles.paths, path)
+	}
+}
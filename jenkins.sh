@@ -4,7 +4,7 @@ set -e
 export BUILD_DIR=$(dirname $0)
 pushd $BUILD_DIR > /dev/null
 
-export GOVERSION=${GOVERSION:-1.6.2}
+export GOVERSION=${GOVERSION:-1.7.6}
 export PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin:/usr/games:/usr/local/games
 export REVISION=${GIT_COMMIT:-$(git rev-parse HEAD)}
 export LIBRARY_REVISION=$(cat Godeps/Godeps.json | grep github.com/phrase/phraseapp-go -A 1 | tail -n 1 | cut -d '"' -f 4)
@@ -73,6 +73,11 @@ for name in phraseapp_linux_386 phraseapp_linux_amd64; do
 done
 
 zip phraseapp_windows_amd64.exe.zip phraseapp_windows_amd64.exe &> /dev/null
+
+# checksums verified by phraseapp selfupdate
+for name in phraseapp_linux_386 phraseapp_linux_amd64 phraseapp_macosx_amd64 phraseapp_windows_amd64.exe; do
+  sha256sum $name > ${name}.sha256
+done
 popd > /dev/null
 
 if [[ -n $WORKSPACE ]]; then
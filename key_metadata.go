@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// metadataSync is a metadata file to apply to the keys of a project after
+// push, see syncKeyMetadata.
+type metadataSync struct {
+	projectID   string
+	accessToken string
+	path        string
+}
+
+// metadataSyncs returns the metadata files of the sources, each once per
+// project. path overrides the metadata file of all sources. Syncing requires
+// a metadata file for every source.
+func (sources Sources) metadataSyncs(path string) ([]*metadataSync, error) {
+	syncs := []*metadataSync{}
+	seen := map[metadataSync]bool{}
+	for _, source := range sources {
+		sync := metadataSync{projectID: source.ProjectID, accessToken: source.AccessToken, path: source.Metadata}
+		if path != "" {
+			sync.path = path
+		}
+		if sync.path == "" {
+			return nil, fmt.Errorf("--update-descriptions requires --metadata or the metadata configuration key of source %s", source.File)
+		}
+		if !seen[sync] {
+			seen[sync] = true
+			syncs = append(syncs, &sync)
+		}
+	}
+	return syncs, nil
+}
+
+// run applies the metadata file and reports the updated and missing keys.
+func (sync *metadataSync) run(clients *clientPool) error {
+	client, err := clients.Client(sync.accessToken)
+	if err != nil {
+		return err
+	}
+
+	updated, missing, err := syncKeyMetadata(client, sync.projectID, sync.path)
+	if !Quiet {
+		for _, name := range updated {
+			fmt.Printf("Updated metadata of key %s\n", name)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return warnf("%s: %d keys not found in project %s, use --wait if they are created by this push: %s", sync.path, len(missing), sync.projectID, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// syncKeyMetadata applies the key parameters in the metadata file, like
+// description, max_characters_allowed or tags, to the existing keys of the
+// project. The file has the format of keys/import. Keys not in the project
+// yet, e.g. because their upload is still processed, are reported as missing.
+func syncKeyMetadata(client *phraseapp.Client, projectID, path string) (updated, missing []string, err error) {
+	rows, err := readKeyRows(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read key metadata: %s", err)
+	}
+
+	for _, row := range rows {
+		if row.params.Name == nil || *row.params.Name == "" {
+			return updated, missing, fmt.Errorf("%s of %s: name missing", row.desc, path)
+		}
+		name := *row.params.Name
+
+		id, err := findKeyID(client, projectID, name)
+		if err != nil {
+			return updated, missing, err
+		}
+		if id == "" {
+			missing = append(missing, name)
+			continue
+		}
+		if _, err := client.KeyUpdate(projectID, id, row.params); err != nil {
+			return updated, missing, fmt.Errorf("failed to update metadata of key %s: %s", name, err)
+		}
+		updated = append(updated, name)
+	}
+	return updated, missing, nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMetadataSyncs(t *testing.T) {
+	sources := Sources{
+		{File: "a/<locale_code>.yml", ProjectID: "p1", Metadata: "keys.csv"},
+		{File: "b/<locale_code>.yml", ProjectID: "p1", Metadata: "keys.csv"},
+		{File: "c/<locale_code>.yml", ProjectID: "p2", Metadata: "keys.csv"},
+	}
+	syncs, err := sources.metadataSyncs("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(syncs) != 2 || syncs[0].projectID != "p1" || syncs[1].projectID != "p2" {
+		t.Errorf("expected the metadata to be synced once per project, got %d syncs", len(syncs))
+	}
+
+	syncs, err = sources.metadataSyncs("other.json")
+	if err != nil || syncs[0].path != "other.json" {
+		t.Errorf("expected --metadata to override the metadata of sources, got %v", err)
+	}
+
+	sources = append(sources, &Source{File: "d/<locale_code>.yml"})
+	if _, err := sources.metadataSyncs(""); err == nil {
+		t.Errorf("expected an error for a source without metadata file")
+	}
+}
+
+func TestSyncKeyMetadata(t *testing.T) {
+	updates := map[string]url.Values{}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v2/projects/project-id/keys/search":
+			io.WriteString(w, `[{"id": "title-id", "name": "home.title"}, {"id": "body-id", "name": "home.body"}]`)
+		case r.Method == "PATCH":
+			r.ParseMultipartForm(1 << 20)
+			updates[r.URL.Path] = r.MultipartForm.Value
+			io.WriteString(w, `{}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer s.Close()
+
+	dir, err := ioutil.TempDir("", "phraseapp-metadata-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keys.csv")
+	csv := "name,description,max_characters_allowed\nhome.title,Title of the home page,40\nhome.missing,Not pushed yet,\n"
+	if err := ioutil.WriteFile(path, []byte(csv), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	updated, missing, err := syncKeyMetadata(newTestClient(s.URL), "project-id", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(updated, []string{"home.title"}) || !reflect.DeepEqual(missing, []string{"home.missing"}) {
+		t.Errorf("expected home.title to be updated and home.missing to be missing, got %v and %v", updated, missing)
+	}
+	params := updates["/v2/projects/project-id/keys/title-id"]
+	if params.Get("description") != "Title of the home page" || params.Get("max_characters_allowed") != "40" {
+		t.Errorf("expected the description and limit to be updated, got %v", params)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("description\nno name\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := syncKeyMetadata(newTestClient(s.URL), "project-id", path); err == nil || !strings.Contains(err.Error(), "name missing") {
+		t.Errorf("expected an error for a row without name, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// keysPage fetches a page of keys, e.g. with KeysList or KeysSearch.
+type keysPage func(client *phraseapp.Client, page, perPage int) ([]*phraseapp.TranslationKey, error)
+
+// countKeys returns the number of keys list finds. The total is taken from the
+// pagination headers of a request for a single key if the API sends them,
+// otherwise all pages are fetched and counted.
+func countKeys(client *phraseapp.Client, list keysPage) (int64, error) {
+	tr := &totalCountTransport{next: client.Transport}
+	if tr.next == nil {
+		tr.next = http.DefaultTransport
+	}
+	counting := *client
+	counting.Client = http.Client{Transport: tr}
+
+	if _, err := list(&counting, 1, 1); err != nil {
+		return 0, err
+	}
+	if total, found := tr.Total(); found {
+		return total, nil
+	}
+	logger.Debugf("no total count in the response headers, counting all keys")
+
+	var count int64
+	for page := 1; ; page++ {
+		keys, err := list(client, page, maxPerPage)
+		if err != nil {
+			return 0, err
+		}
+		count += int64(len(keys))
+		if len(keys) < maxPerPage {
+			return count, nil
+		}
+	}
+}
+
+// totalCountTransport records the total count of the paginated response last
+// received.
+type totalCountTransport struct {
+	next http.RoundTripper
+
+	mu    sync.Mutex
+	total int64
+	found bool
+}
+
+func (t *totalCountTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	total, found := totalCount(resp.Header)
+	t.mu.Lock()
+	t.total, t.found = total, found
+	t.mu.Unlock()
+	return resp, nil
+}
+
+func (t *totalCountTransport) Total() (int64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.total, t.found
+}
+
+// totalCount reads the number of records of a paginated response from the
+// X-Total-Count header or the total_count of the JSON Pagination header.
+func totalCount(header http.Header) (int64, bool) {
+	if value := header.Get("X-Total-Count"); value != "" {
+		total, err := strconv.ParseInt(value, 10, 64)
+		return total, err == nil && total >= 0
+	}
+	if value := header.Get("Pagination"); value != "" {
+		pagination := struct {
+			TotalCount *int64 `json:"total_count"`
+		}{}
+		if err := json.Unmarshal([]byte(value), &pagination); err != nil || pagination.TotalCount == nil {
+			return 0, false
+		}
+		return *pagination.TotalCount, *pagination.TotalCount >= 0
+	}
+	return 0, false
+}
+
+// checkCountFlags returns an error if --count is combined with flags changing
+// the printed keys.
+func checkCountFlags(count, keysOnly bool, template string) error {
+	if count && (keysOnly || template != "") {
+		return &invalidError{fmt.Errorf("--count can't be combined with --keys-only or --template")}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestCountKeys(t *testing.T) {
+	search := func(client *phraseapp.Client, page, perPage int) ([]*phraseapp.TranslationKey, error) {
+		return client.KeysSearch("project-id", page, perPage, &phraseapp.KeysSearchParams{})
+	}
+
+	requests := []string{}
+	withHeader := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Query().Get("page")+"/"+r.URL.Query().Get("per_page"))
+		if withHeader {
+			w.Header().Set("Pagination", `{"total_count":1234,"current_page":1}`)
+			io.WriteString(w, `[{"id":"1"}]`)
+			return
+		}
+		keys := make([]string, maxPerPage)
+		if r.URL.Query().Get("page") == "2" {
+			keys = keys[:3]
+		}
+		for i := range keys {
+			keys[i] = fmt.Sprintf(`{"id":"%d"}`, i)
+		}
+		io.WriteString(w, "["+strings.Join(keys, ",")+"]")
+	}))
+	defer srv.Close()
+	client := newTestClient(srv.URL)
+
+	count, err := countKeys(client, search)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1234 || strings.Join(requests, ",") != "1/1" {
+		t.Errorf("expected the total of the header from a single request, got %d from %v", count, requests)
+	}
+
+	requests = requests[:0]
+	withHeader = false
+	count, err = countKeys(client, search)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := fmt.Sprintf("1/1,1/%d,2/%d", maxPerPage, maxPerPage)
+	if count != int64(maxPerPage+3) || strings.Join(requests, ",") != expected {
+		t.Errorf("expected %d keys counted from all pages, got %d from %v", maxPerPage+3, count, requests)
+	}
+}
+
+func TestTotalCount(t *testing.T) {
+	tests := []struct {
+		header http.Header
+		total  int64
+		found  bool
+	}{
+		{http.Header{"X-Total-Count": {"42"}}, 42, true},
+		{http.Header{"Pagination": {`{"total_count":7}`}}, 7, true},
+		{http.Header{"Pagination": {`{"current_page":1}`}}, 0, false},
+		{http.Header{"X-Total-Count": {"many"}}, 0, false},
+		{http.Header{}, 0, false},
+	}
+	for _, test := range tests {
+		total, found := totalCount(test.header)
+		if total != test.total || found != test.found {
+			t.Errorf("expected %d, %t for %v, got %d, %t", test.total, test.found, test.header, total, found)
+		}
+	}
+}
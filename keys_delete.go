@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// keysDeleteSoftLimit is the number of keys keys/delete should affect at most,
+// larger deletes might time out.
+const keysDeleteSoftLimit = 1000
+
+// confirmKeysDelete shows how many keys command is about to delete and asks for
+// confirmation. Without a terminal --yes is required. Returns false if no key
+// matches, so there is nothing to delete.
+func confirmKeysDelete(client *phraseapp.Client, projectID string, params *phraseapp.KeysDeleteParams, command string) (bool, error) {
+	count, err := countMatchingKeys(client, projectID, params, keysDeleteSoftLimit)
+	if err != nil {
+		return false, err
+	}
+
+	if count == 0 {
+		if !Quiet {
+			fmt.Fprintln(os.Stderr, "No keys match the query, nothing to delete")
+		}
+		return false, nil
+	}
+
+	matching := describeKeyCount(count, keysDeleteSoftLimit)
+	if !Quiet {
+		fmt.Fprintf(os.Stderr, "%s match the query\n", matching)
+	}
+	if count > keysDeleteSoftLimit {
+		if err := warnf("deleting more than %d keys at once might time out, consider narrowing the query", keysDeleteSoftLimit); err != nil {
+			return false, err
+		}
+	}
+
+	if err := confirmKeysDeletion(client, matching, command); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// confirmKeysDeletion asks for confirmation to delete the keys described by
+// matching, e.g. "42 keys". Without a terminal --yes is required.
+func confirmKeysDeletion(client *phraseapp.Client, matching, command string) error {
+	if !AssumeYes && !isTerminal(os.Stdin) {
+		return &invalidError{fmt.Errorf("%s needs --yes to delete %s when not run on a terminal", command, matching)}
+	}
+	return confirmDestructive(client, "delete "+matching)
+}
+
+// countMatchingKeys returns the number of keys matching the query of params.
+// Counting stops once more than limit keys were found.
+func countMatchingKeys(client *phraseapp.Client, projectID string, params *phraseapp.KeysDeleteParams, limit int) (int, error) {
+	search := &phraseapp.KeysSearchParams{LocaleID: params.LocaleID, Q: params.Q}
+
+	count := 0
+	for page := 1; count <= limit; page++ {
+		keys, err := client.KeysSearch(projectID, page, maxPerPage, search)
+		if err != nil {
+			return 0, err
+		}
+		count += len(keys)
+		if len(keys) < maxPerPage {
+			break
+		}
+	}
+	return count, nil
+}
+
+func describeKeyCount(count, limit int) string {
+	switch {
+	case count > limit:
+		return fmt.Sprintf("more than %d keys", limit)
+	case count == 1:
+		return "1 key"
+	}
+	return fmt.Sprintf("%d keys", count)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestCountMatchingKeys(t *testing.T) {
+	total, requests := 250, 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if !strings.HasSuffix(r.URL.Path, "/keys/search") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		n := total - (requests-1)*maxPerPage
+		if n > maxPerPage {
+			n = maxPerPage
+		}
+		keys := []string{}
+		for i := 0; i < n; i++ {
+			keys = append(keys, fmt.Sprintf(`{"id":"%d"}`, i))
+		}
+		io.WriteString(w, "["+strings.Join(keys, ",")+"]")
+	}))
+	defer srv.Close()
+
+	q := "name:home.*"
+	params := &phraseapp.KeysDeleteParams{Q: &q}
+	count, err := countMatchingKeys(newTestClient(srv.URL), "project-id", params, keysDeleteSoftLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 250 || requests != 3 {
+		t.Errorf("expected 250 keys in 3 requests, got %d in %d", count, requests)
+	}
+
+	total, requests = 5000, 0
+	count, err = countMatchingKeys(newTestClient(srv.URL), "project-id", params, keysDeleteSoftLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count <= keysDeleteSoftLimit || requests != 11 {
+		t.Errorf("expected counting to stop after the limit, got %d keys in %d requests", count, requests)
+	}
+}
+
+func TestDescribeKeyCount(t *testing.T) {
+	for count, expected := range map[int]string{1: "1 key", 42: "42 keys", 1000: "1000 keys", 1001: "more than 1000 keys"} {
+		if got := describeKeyCount(count, 1000); got != expected {
+			t.Errorf("%d: expected %q, got %q", count, expected, got)
+		}
+	}
+}
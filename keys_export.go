@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// keysExportPageSize is the maximum page size accepted by the API.
+const keysExportPageSize = 100
+
+// KeysExportCommand writes all keys of a project as newline delimited JSON.
+type KeysExportCommand struct {
+	*phraseapp.Config
+
+	phraseapp.KeysListParams
+
+	File string `cli:"opt --file required desc='file to write the keys to, - for stdout'"`
+
+	ProjectID string `cli:"arg required"`
+}
+
+func newKeysExport(cfg *phraseapp.Config) (*KeysExportCommand, error) {
+	cmd := &KeysExportCommand{Config: cfg}
+	cmd.ProjectID = cfg.DefaultProjectID
+
+	if val, found := cmd.Config.Defaults["keys/export"]; found {
+		if err := cmd.ApplyValuesFromMap(val); err != nil {
+			return nil, err
+		}
+	}
+	return cmd, nil
+}
+
+func (cmd *KeysExportCommand) Run() error {
+	client, err := newClient(cmd.Config.Credentials)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if cmd.File != stdoutFile {
+		f, err := os.Create(cmd.File)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	count, err := exportKeys(client, cmd.ProjectID, &cmd.KeysListParams, w)
+	if err != nil {
+		return err
+	}
+
+	if !Quiet {
+		// Keep stdout clean for the exported keys.
+		out := os.Stdout
+		if cmd.File == stdoutFile {
+			out = os.Stderr
+		}
+		fmt.Fprintf(out, "Exported %d keys\n", count)
+	}
+	return nil
+}
+
+// exportKeys fetches all keys page by page and writes each one as a line of
+// JSON to w, so only a single page is held in memory at a time. Returns the
+// number of keys written.
+func exportKeys(client *phraseapp.Client, projectID string, params *phraseapp.KeysListParams, w io.Writer) (int, error) {
+	enc := json.NewEncoder(w)
+	count := 0
+	for page := 1; ; page++ {
+		keys, err := client.KeysList(projectID, page, keysExportPageSize, params)
+		if err != nil {
+			return count, err
+		}
+		for _, key := range keys {
+			if err := enc.Encode(key); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if len(keys) < keysExportPageSize {
+			return count, nil
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestExportKeys(t *testing.T) {
+	total := keysExportPageSize + 3
+	pages := []string{}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+
+		keys := []map[string]string{}
+		first := 0
+		if page == "2" {
+			first = keysExportPageSize
+		}
+		for i := first; i < total && i < first+keysExportPageSize; i++ {
+			keys = append(keys, map[string]string{"id": fmt.Sprintf("key-%d", i)})
+		}
+		json.NewEncoder(w).Encode(keys)
+	}))
+	defer s.Close()
+
+	out := &bytes.Buffer{}
+	count, err := exportKeys(newTestClient(s.URL), "project-id", nil, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != total {
+		t.Errorf("expected %d keys to be exported, got %d", total, count)
+	}
+	if expected := "1,2"; strings.Join(pages, ",") != expected {
+		t.Errorf("expected pages %s to be requested, got %v", expected, pages)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != total {
+		t.Fatalf("expected %d lines, got %d", total, len(lines))
+	}
+	if !strings.Contains(lines[total-1], fmt.Sprintf(`"id":"key-%d"`, total-1)) {
+		t.Errorf("expected last line to contain the last key, got %s", lines[total-1])
+	}
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// KeysImportCommand creates keys from the rows of a CSV or JSON file.
+type KeysImportCommand struct {
+	*phraseapp.Config
+
+	File           string `cli:"opt --file required desc='CSV (with a header row) or JSON file containing the keys'"`
+	Concurrency    int    `cli:"opt --concurrency default=4 desc='number of keys created in parallel'"`
+	UpdateExisting bool   `cli:"opt --update-existing desc='update keys that already exist instead of failing'"`
+
+	ProjectID string `cli:"arg required"`
+}
+
+func newKeysImport(cfg *phraseapp.Config) *KeysImportCommand {
+	cmd := &KeysImportCommand{Config: cfg}
+	cmd.ProjectID = cfg.DefaultProjectID
+	return cmd
+}
+
+func (cmd *KeysImportCommand) Run() error {
+	client, err := newClient(cmd.Config.Credentials)
+	if err != nil {
+		return err
+	}
+
+	rows, err := readKeyRows(cmd.File)
+	if err != nil {
+		return err
+	}
+
+	importer := &keyImporter{client: client, projectID: cmd.ProjectID, updateExisting: cmd.UpdateExisting}
+	results := importer.Import(rows, cmd.Concurrency)
+
+	created, updated, failed := 0, 0, 0
+	for _, res := range results {
+		switch {
+		case res.err != nil:
+			failed++
+			logger.Errorf("%s: %s", res.row.desc, res.err)
+		case res.updated:
+			updated++
+		default:
+			created++
+		}
+	}
+
+	if !Quiet {
+		fmt.Printf("Created %d keys, updated %d, failed %d\n", created, updated, failed)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d keys could not be imported", failed, len(rows))
+	}
+	return nil
+}
+
+// A keyRow is a single key to import, desc identifies it in error messages.
+type keyRow struct {
+	desc   string
+	params *phraseapp.TranslationKeyParams
+}
+
+type keyImportResult struct {
+	row     *keyRow
+	updated bool
+	err     error
+}
+
+type keyImporter struct {
+	client         *phraseapp.Client
+	projectID      string
+	updateExisting bool
+}
+
+// Import creates the keys using the given number of parallel requests. The
+// results are in the order of the rows.
+func (importer *keyImporter) Import(rows []*keyRow, concurrency int) []*keyImportResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]*keyImportResult, len(rows))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = importer.importRow(rows[i])
+			}
+		}()
+	}
+	for i := range rows {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+func (importer *keyImporter) importRow(row *keyRow) *keyImportResult {
+	res := &keyImportResult{row: row}
+	if row.params.Name == nil || *row.params.Name == "" {
+		res.err = fmt.Errorf("name missing")
+		return res
+	}
+
+	if importer.updateExisting {
+		id, err := findKeyID(importer.client, importer.projectID, *row.params.Name)
+		if err != nil {
+			res.err = err
+			return res
+		}
+		if id != "" {
+			_, res.err = importer.client.KeyUpdate(importer.projectID, id, row.params)
+			res.updated = true
+			return res
+		}
+	}
+
+	_, res.err = importer.client.KeyCreate(importer.projectID, row.params)
+	return res
+}
+
+// findKeyID returns the ID of the key with exactly the given name, or an
+// empty string if there is none.
+func findKeyID(client *phraseapp.Client, projectID, name string) (string, error) {
+	key, err := findKey(client, projectID, name)
+	if err != nil || key == nil {
+		return "", err
+	}
+	return key.ID, nil
+}
+
+// findKey returns the key with exactly the given name, or nil if there is
+// none.
+func findKey(client *phraseapp.Client, projectID, name string) (*phraseapp.TranslationKey, error) {
+	q := "name:" + name
+	keys, err := client.KeysSearch(projectID, 1, maxPerPage, &phraseapp.KeysSearchParams{Q: &q})
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.Name == name {
+			return key, nil
+		}
+	}
+	return nil, nil
+}
+
+// readKeyRows reads the keys from a JSON file (a list of objects) or a CSV
+// file. Both use the parameter names of key/create, e.g. name or
+// max_characters_allowed.
+func readKeyRows(path string) ([]*keyRow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		return readKeyRowsJSON(f)
+	}
+	return readKeyRowsCSV(f)
+}
+
+func readKeyRowsJSON(r io.Reader) ([]*keyRow, error) {
+	list := []*phraseapp.TranslationKeyParams{}
+	if err := json.NewDecoder(r).Decode(&list); err != nil {
+		return nil, err
+	}
+
+	rows := make([]*keyRow, 0, len(list))
+	for i, params := range list {
+		if params == nil {
+			continue
+		}
+		rows = append(rows, &keyRow{desc: fmt.Sprintf("entry %d", i+1), params: params})
+	}
+	return rows, nil
+}
+
+func readKeyRowsCSV(r io.Reader) ([]*keyRow, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("CSV file is empty, expected a header row")
+	}
+
+	header := records[0]
+	rows := make([]*keyRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		params, err := keyParamsFromRecord(header, record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+2, err)
+		}
+		rows = append(rows, &keyRow{desc: fmt.Sprintf("line %d", i+2), params: params})
+	}
+	return rows, nil
+}
+
+// keyParamsFromRecord sets the parameters named in header from the values of
+// the record. Empty values are left unset.
+func keyParamsFromRecord(header, record []string) (*phraseapp.TranslationKeyParams, error) {
+	params := new(phraseapp.TranslationKeyParams)
+	v := reflect.ValueOf(params).Elem()
+
+	fields := map[string]reflect.Value{}
+	for i := 0; i < v.NumField(); i++ {
+		name := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+		fields[name] = v.Field(i)
+	}
+
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		if i >= len(record) || record[i] == "" {
+			continue
+		}
+		field, found := fields[column]
+		if !found {
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+
+		value := reflect.New(field.Type().Elem())
+		switch value.Elem().Kind() {
+		case reflect.String:
+			value.Elem().SetString(record[i])
+		case reflect.Bool:
+			b, err := strconv.ParseBool(record[i])
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q for %s", record[i], column)
+			}
+			value.Elem().SetBool(b)
+		case reflect.Int64:
+			n, err := strconv.ParseInt(record[i], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q for %s", record[i], column)
+			}
+			value.Elem().SetInt(n)
+		default:
+			return nil, fmt.Errorf("column %q is not supported", column)
+		}
+		field.Set(value)
+	}
+	return params, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestReadKeyRowsCSV(t *testing.T) {
+	rows, err := readKeyRowsCSV(strings.NewReader("name,description,max_characters_allowed,plural\nhello,A greeting,20,false\nbye,,,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+
+	params := rows[0].params
+	if *params.Name != "hello" || *params.Description != "A greeting" || *params.MaxCharactersAllowed != 20 || *params.Plural {
+		t.Errorf("unexpected params for the first row: %+v", params)
+	}
+	if params := rows[1].params; *params.Name != "bye" || params.Description != nil || params.MaxCharactersAllowed != nil {
+		t.Errorf("expected empty values to be left unset, got %+v", params)
+	}
+
+	if _, err := readKeyRowsCSV(strings.NewReader("name,color\nhello,red\n")); err == nil {
+		t.Errorf("expected an error for an unknown column")
+	}
+	if _, err := readKeyRowsCSV(strings.NewReader("name,plural\nhello,maybe\n")); err == nil {
+		t.Errorf("expected an error for an invalid bool")
+	}
+}
+
+func TestReadKeyRowsJSON(t *testing.T) {
+	rows, err := readKeyRowsJSON(strings.NewReader(`[{"name": "hello", "tags": "a,b", "max_characters_allowed": 20}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || *rows[0].params.Name != "hello" || *rows[0].params.Tags != "a,b" || *rows[0].params.MaxCharactersAllowed != 20 {
+		t.Errorf("unexpected rows: %+v", rows)
+	}
+}
+
+func TestKeyImporter(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/v2/projects/project-id/keys/search":
+			io.WriteString(w, `[{"id": "existing-id", "name": "existing"}, {"id": "other-id", "name": "existing.other"}]`)
+		case "/v2/projects/project-id/keys":
+			r.ParseMultipartForm(1 << 20)
+			if r.FormValue("name") == "broken" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				io.WriteString(w, `{"message": "Validation failed", "errors": []}`)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{}`)
+		default:
+			io.WriteString(w, `{}`)
+		}
+	}))
+	defer s.Close()
+
+	rows, err := readKeyRowsCSV(strings.NewReader("name\nexisting\nnew\nbroken\n\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	importer := &keyImporter{client: newTestClient(s.URL), projectID: "project-id", updateExisting: true}
+	results := importer.Import(rows, 2)
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if !results[0].updated || results[0].err != nil {
+		t.Errorf("expected the existing key to be updated, got %+v", results[0])
+	}
+	if results[1].updated || results[1].err != nil {
+		t.Errorf("expected the new key to be created, got %+v", results[1])
+	}
+	if results[2].err == nil {
+		t.Errorf("expected the broken key to fail")
+	}
+	if n := requests["PATCH /v2/projects/project-id/keys/existing-id"]; n != 1 {
+		t.Errorf("expected the existing key to be updated once, got %d requests", n)
+	}
+}
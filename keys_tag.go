@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// keysTagBatchSize is the number of key IDs tagged with a single request, to
+// keep the query short.
+const keysTagBatchSize = 100
+
+// keyTagResult counts the keys of a --from-file of keys/tag or keys/untag.
+type keyTagResult struct {
+	Affected int64
+	Skipped  int
+	NotFound []string
+}
+
+func (res *keyTagResult) summary(action string) string {
+	return fmt.Sprintf("%s %d keys, skipped %d, %d not found", action, res.Affected, res.Skipped, len(res.NotFound))
+}
+
+// readKeyNames reads the key names of a --from-file, one per line. Empty
+// lines and duplicates are ignored.
+func readKeyNames(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	names := []string{}
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names, scanner.Err()
+}
+
+// tagKeysFromFile tags (or untags with untag set) the keys named in the file
+// at path with the comma separated tags. Keys are looked up by name first, so
+// keys already tagged (or not tagged) are skipped and missing ones reported.
+// The others are tagged by ID in batches of keysTagBatchSize.
+func tagKeysFromFile(client *phraseapp.Client, projectID, path, tags string, localeID *string, untag bool) (*keyTagResult, error) {
+	if tags == "" {
+		return nil, &invalidError{fmt.Errorf("--from-file requires --tags")}
+	}
+	names, err := readKeyNames(path)
+	if err != nil {
+		return nil, &invalidError{fmt.Errorf("failed to read key names: %s", err)}
+	}
+
+	res := &keyTagResult{NotFound: []string{}}
+	ids := []string{}
+	for _, name := range names {
+		key, err := findKey(client, projectID, name)
+		switch {
+		case err != nil:
+			return nil, err
+		case key == nil:
+			res.NotFound = append(res.NotFound, name)
+		case untag && countTags(key.Tags, tags) == 0, !untag && countTags(key.Tags, tags) == len(splitTags(tags)):
+			res.Skipped++
+		default:
+			ids = append(ids, key.ID)
+		}
+	}
+
+	for start := 0; start < len(ids); start += keysTagBatchSize {
+		end := start + keysTagBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		q := "ids:" + strings.Join(ids[start:end], ",")
+
+		var affected *phraseapp.AffectedResources
+		if untag {
+			affected, err = client.KeysUntag(projectID, &phraseapp.KeysUntagParams{LocaleID: localeID, Q: &q, Tags: &tags})
+		} else {
+			affected, err = client.KeysTag(projectID, &phraseapp.KeysTagParams{LocaleID: localeID, Q: &q, Tags: &tags})
+		}
+		if err != nil {
+			return res, err
+		}
+		res.Affected += affected.RecordsAffected
+	}
+
+	if len(res.NotFound) > 0 {
+		if err := warnf("%d keys of %s not found: %s", len(res.NotFound), path, strings.Join(res.NotFound, ", ")); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// countTags returns how many of the comma separated tags keyTags contain.
+func countTags(keyTags []string, tags string) int {
+	has := map[string]bool{}
+	for _, tag := range keyTags {
+		has[tag] = true
+	}
+	count := 0
+	for _, tag := range splitTags(tags) {
+		if has[tag] {
+			count++
+		}
+	}
+	return count
+}
+
+// splitTags splits comma separated tags, ignoring empty ones.
+func splitTags(tags string) []string {
+	split := []string{}
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			split = append(split, tag)
+		}
+	}
+	return split
+}
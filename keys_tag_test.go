@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadKeyNames(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp-keys-tag-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keys.txt")
+	if err := ioutil.WriteFile(path, []byte("home.title\n\n  home.body \nhome.title\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	names, err := readKeyNames(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"home.title", "home.body"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+}
+
+func TestTagKeysFromFile(t *testing.T) {
+	keys := map[string]string{
+		"home.title":  `{"id": "title-id", "name": "home.title", "tags": []}`,
+		"home.body":   `{"id": "body-id", "name": "home.body", "tags": ["feature"]}`,
+		"home.footer": `{"id": "footer-id", "name": "home.footer", "tags": ["other"]}`,
+	}
+	queries := []string{}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params struct {
+			Q    string `json:"q"`
+			Tags string `json:"tags"`
+		}
+		json.NewDecoder(r.Body).Decode(&params)
+		switch r.URL.Path {
+		case "/v2/projects/project-id/keys/search":
+			fmt.Fprintf(w, "[%s]", keys[strings.TrimPrefix(params.Q, "name:")])
+		case "/v2/projects/project-id/keys/tag", "/v2/projects/project-id/keys/untag":
+			queries = append(queries, params.Q)
+			fmt.Fprintf(w, `{"records_affected": %d}`, len(strings.Split(params.Q, ",")))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer s.Close()
+
+	dir, err := ioutil.TempDir("", "phraseapp-keys-tag-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keys.txt")
+	if err := ioutil.WriteFile(path, []byte("home.title\nhome.body\nhome.footer\nhome.missing\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	client := newTestClient(s.URL)
+	res, err := tagKeysFromFile(client, "project-id", path, "feature", nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Affected != 2 || res.Skipped != 1 || !reflect.DeepEqual(res.NotFound, []string{"home.missing"}) {
+		t.Errorf("expected 2 keys tagged, 1 skipped and home.missing not found, got %+v", res)
+	}
+	if expected := []string{"ids:title-id,footer-id"}; !reflect.DeepEqual(queries, expected) {
+		t.Errorf("expected the keys to be tagged by ID, got %v", queries)
+	}
+
+	queries = nil
+	res, err = tagKeysFromFile(client, "project-id", path, "feature", nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Affected != 1 || res.Skipped != 2 {
+		t.Errorf("expected 1 key untagged and 2 skipped, got %+v", res)
+	}
+	if expected := []string{"ids:body-id"}; !reflect.DeepEqual(queries, expected) {
+		t.Errorf("expected only the tagged key to be untagged, got %v", queries)
+	}
+
+	if _, err := tagKeysFromFile(client, "project-id", path, "", nil, false); err == nil {
+		t.Errorf("expected an error without tags")
+	}
+}
+
+func TestTagKeysFromFileBatches(t *testing.T) {
+	batches := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params struct {
+			Q string `json:"q"`
+		}
+		json.NewDecoder(r.Body).Decode(&params)
+		if r.URL.Path == "/v2/projects/project-id/keys/tag" {
+			batches++
+			fmt.Fprintf(w, `{"records_affected": %d}`, len(strings.Split(params.Q, ",")))
+			return
+		}
+		name := strings.TrimPrefix(params.Q, "name:")
+		fmt.Fprintf(w, `[{"id": "%s-id", "name": "%s"}]`, name, name)
+	}))
+	defer s.Close()
+
+	dir, err := ioutil.TempDir("", "phraseapp-keys-tag-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	names := []string{}
+	for i := 0; i < keysTagBatchSize+1; i++ {
+		names = append(names, fmt.Sprintf("key%d", i))
+	}
+	path := filepath.Join(dir, "keys.txt")
+	if err := ioutil.WriteFile(path, []byte(strings.Join(names, "\n")), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := tagKeysFromFile(newTestClient(s.URL), "project-id", path, "feature", nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if batches != 2 || res.Affected != int64(len(names)) {
+		t.Errorf("expected %d keys to be tagged in 2 requests, got %d in %d", len(names), res.Affected, batches)
+	}
+}
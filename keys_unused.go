@@ -0,0 +1,91 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// keysDeleteBatchSize is the number of key IDs deleted with a single request,
+// to keep the query short.
+const keysDeleteBatchSize = 100
+
+// translatedKeyIDs returns the IDs of the keys with a non-empty translation in
+// any locale, or in the given locale if localeID is set.
+func translatedKeyIDs(client *phraseapp.Client, projectID string, localeID *string) (map[string]bool, error) {
+	list := func(page, perPage int) ([]*phraseapp.Translation, error) {
+		if localeID != nil && *localeID != "" {
+			return client.TranslationsByLocale(projectID, *localeID, page, perPage, &phraseapp.TranslationsByLocaleParams{})
+		}
+		return client.TranslationsList(projectID, page, perPage, &phraseapp.TranslationsListParams{})
+	}
+	translations, err := listTranslations(list, 1, maxPerPage, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[string]bool{}
+	for _, translation := range translations {
+		if translation.Key != nil && translation.Content != "" {
+			ids[translation.Key.ID] = true
+		}
+	}
+	return ids, nil
+}
+
+// unusedKeys returns the keys matching params without any translation, or
+// without translation in the locale of params. Keys and translations are
+// fetched page by page with the largest page size.
+func unusedKeys(client *phraseapp.Client, projectID string, params *phraseapp.KeysListParams) ([]*phraseapp.TranslationKey, error) {
+	translated, err := translatedKeyIDs(client, projectID, params.LocaleID)
+	if err != nil {
+		return nil, err
+	}
+
+	unused := []*phraseapp.TranslationKey{}
+	for page := 1; ; page++ {
+		keys, err := client.KeysList(projectID, page, maxPerPage, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			if !translated[key.ID] {
+				unused = append(unused, key)
+			}
+		}
+		if len(keys) < maxPerPage {
+			return unused, nil
+		}
+	}
+}
+
+// deleteKeys deletes the keys by ID in batches of keysDeleteBatchSize, after
+// confirmation like keys/delete. Returns the number of deleted keys.
+func deleteKeys(client *phraseapp.Client, projectID string, keys []*phraseapp.TranslationKey, command string) (int64, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	if err := confirmKeysDeletion(client, describeKeyCount(len(keys), len(keys)), command); err != nil {
+		return 0, err
+	}
+
+	deleted := int64(0)
+	for start := 0; start < len(keys); start += keysDeleteBatchSize {
+		end := start + keysDeleteBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		ids := []string{}
+		for _, key := range keys[start:end] {
+			ids = append(ids, key.ID)
+		}
+		q := "ids:" + strings.Join(ids, ",")
+
+		res, err := client.KeysDelete(projectID, &phraseapp.KeysDeleteParams{Q: &q})
+		if err != nil {
+			return deleted, err
+		}
+		deleted += res.RecordsAffected
+	}
+	return deleted, nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestUnusedKeys(t *testing.T) {
+	defer func(yes bool) { AssumeYes = yes }(AssumeYes)
+	AssumeYes = true
+
+	deleteQuery := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/locales/de/translations"):
+			io.WriteString(w, `[{"content":"Hallo","key":{"id":"1"}}]`)
+		case strings.HasSuffix(r.URL.Path, "/translations"):
+			io.WriteString(w, `[{"content":"Hi","key":{"id":"1"}},{"content":"","key":{"id":"2"}},{"content":"Hallo","key":{"id":"3"}}]`)
+		case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/keys"):
+			io.WriteString(w, `[{"id":"1","name":"home.title"},{"id":"2","name":"home.body"},{"id":"3","name":"home.footer"},{"id":"4","name":"legacy"}]`)
+		case r.Method == "DELETE" && strings.HasSuffix(r.URL.Path, "/keys"):
+			var params struct {
+				Q string `json:"q"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+				t.Error(err)
+			}
+			deleteQuery = params.Q
+			io.WriteString(w, `{"records_affected":2}`)
+		case strings.HasSuffix(r.URL.Path, "/authorizations"):
+			io.WriteString(w, `[]`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+	client := newTestClient(srv.URL)
+
+	keys, err := unusedKeys(client, "project-id", &phraseapp.KeysListParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := keyNames(keys); names != "home.body,legacy" {
+		t.Errorf("expected the keys without translation, got %s", names)
+	}
+
+	de := "de"
+	keys, err = unusedKeys(client, "project-id", &phraseapp.KeysListParams{LocaleID: &de})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := keyNames(keys); names != "home.body,home.footer,legacy" {
+		t.Errorf("expected the keys without translation in de, got %s", names)
+	}
+
+	deleted, err := deleteKeys(client, "project-id", keys[:2], "keys/list --delete")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 2 || deleteQuery != "ids:2,3" {
+		t.Errorf("expected 2 keys to be deleted by ID, got %d with query %q", deleted, deleteQuery)
+	}
+}
+
+func keyNames(keys []*phraseapp.TranslationKey) string {
+	names := []string{}
+	for _, key := range keys {
+		names = append(names, key.Name)
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Values of --line-endings.
+const (
+	lineEndingsKeep = "keep"
+	lineEndingsLF   = "lf"
+	lineEndingsCRLF = "crlf"
+)
+
+// binaryFormats are the file formats whose files aren't text, so their line
+// endings must not be touched.
+var binaryFormats = map[string]bool{
+	"xlsx":       true,
+	"gettext_mo": true,
+}
+
+// parseLineEndings validates the value of --line-endings or the line_endings
+// of a target. Empty means keep.
+func parseLineEndings(value string) (string, error) {
+	switch v := strings.ToLower(value); v {
+	case "", lineEndingsKeep:
+		return lineEndingsKeep, nil
+	case lineEndingsLF, lineEndingsCRLF:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid line endings %q, use lf, crlf or keep", value)
+}
+
+// normalizeLineEndings converts all line endings in content to LF or CRLF.
+// Content of binary formats, or containing NUL bytes like binary files do, is
+// returned as it is.
+func normalizeLineEndings(content []byte, lineEndings, format string) []byte {
+	if lineEndings == lineEndingsKeep || binaryFormats[format] || bytes.IndexByte(content, 0) >= 0 {
+		return content
+	}
+
+	lf := bytes.Replace(content, []byte("\r\n"), []byte("\n"), -1)
+	lf = bytes.Replace(lf, []byte("\r"), []byte("\n"), -1)
+	if lineEndings == lineEndingsCRLF {
+		return bytes.Replace(lf, []byte("\n"), []byte("\r\n"), -1)
+	}
+	return lf
+}
@@ -0,0 +1,54 @@
+package main
+
+import "testing"
+
+func TestParseLineEndings(t *testing.T) {
+	for value, expected := range map[string]string{"": "keep", "keep": "keep", "LF": "lf", "crlf": "crlf"} {
+		got, err := parseLineEndings(value)
+		if err != nil || got != expected {
+			t.Errorf("%q: expected %q, got %q (%v)", value, expected, got, err)
+		}
+	}
+	if _, err := parseLineEndings("cr"); err == nil {
+		t.Errorf("expected an error for invalid line endings")
+	}
+}
+
+func TestNormalizeLineEndings(t *testing.T) {
+	mixed := "a: 1\r\nb: 2\nc: 3\rd: 4\n"
+	tt := []struct {
+		lineEndings, format, in, expected string
+	}{
+		{"keep", "yml", mixed, mixed},
+		{"lf", "yml", mixed, "a: 1\nb: 2\nc: 3\nd: 4\n"},
+		{"crlf", "yml", mixed, "a: 1\r\nb: 2\r\nc: 3\r\nd: 4\r\n"},
+		{"lf", "xlsx", mixed, mixed},
+		{"lf", "yml", "a\r\n\x00b", "a\r\n\x00b"},
+	}
+	for _, tc := range tt {
+		if got := string(normalizeLineEndings([]byte(tc.in), tc.lineEndings, tc.format)); got != tc.expected {
+			t.Errorf("%s/%s: expected %q, got %q", tc.lineEndings, tc.format, tc.expected, got)
+		}
+	}
+}
+
+func TestTargetEncodeLineEndings(t *testing.T) {
+	target := getBaseTarget()
+	target.LineEndings = "crlf"
+	target.Encoding = "UTF-16LE"
+	if err := target.checkEncoding(); err != nil {
+		t.Fatal(err)
+	}
+	out, err := target.encode([]byte("a\nb"), "yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "a\x00\r\x00\n\x00b\x00"; string(out) != expected {
+		t.Errorf("expected line endings to be converted before transcoding, got %q", out)
+	}
+
+	target.LineEndings = "native"
+	if err := target.checkEncoding(); err == nil {
+		t.Errorf("expected an error for invalid line endings")
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// localeSeed is the file locale/create --from-file uploads to the created
+// locale.
+type localeSeed struct {
+	path     string
+	format   string
+	interval time.Duration
+}
+
+// newLocaleSeed checks the file at path and determines its format: the given
+// one, the configured default or the one detected from the extension. Returns
+// nil without path.
+func newLocaleSeed(client *phraseapp.Client, path, format, defaultFormat, pollInterval string) (*localeSeed, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if err := Exists(path); err != nil {
+		return nil, &invalidError{err}
+	}
+	interval, err := parsePollInterval(pollInterval)
+	if err != nil {
+		return nil, &invalidError{err}
+	}
+
+	if format == "" {
+		format = defaultFormat
+	}
+	if format == "" {
+		formats, err := client.FormatsList(1, maxPerPage)
+		if err != nil {
+			return nil, err
+		}
+		detected, err := detectFormat(formats, path)
+		if err != nil {
+			return nil, &invalidError{fmt.Errorf("can't detect the format of %s from its extension, pass --file-format", path)}
+		}
+		format = detected.ApiName
+	}
+	return &localeSeed{path: path, format: format, interval: interval}, nil
+}
+
+// upload uploads the file to the newly created locale and waits until the
+// upload has been processed. If uploading fails, the locale is deleted again,
+// so the command can simply be run again.
+func (seed *localeSeed) upload(client *phraseapp.Client, projectID string, locale *phraseapp.LocaleDetails) (*phraseapp.Upload, error) {
+	params := &phraseapp.UploadParams{File: &seed.path, FileFormat: &seed.format, LocaleID: &locale.ID}
+	upload, err := client.UploadCreate(projectID, params)
+	if err == nil {
+		upload, err = waitForUpload(client, projectID, upload, seed.interval)
+	}
+	if err == nil {
+		return upload, nil
+	}
+
+	if deleteErr := client.LocaleDelete(projectID, locale.ID); deleteErr != nil {
+		return nil, fmt.Errorf("uploading %s failed: %s\nDeleting the created locale %s (%s) failed as well, delete it yourself: %s", seed.path, err, locale.Name, locale.ID, deleteErr)
+	}
+	return nil, fmt.Errorf("uploading %s failed, deleted the created locale %s again: %s", seed.path, locale.Name, err)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestLocaleSeed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp-locale")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "de.yml")
+	if err := ioutil.WriteFile(path, []byte("de:\n  hello: Hallo\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	state, deleted := "success", ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/formats"):
+			io.WriteString(w, `[{"api_name":"yml","extension":"yml","importable":true},{"api_name":"csv","extension":"csv","importable":true}]`)
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/uploads"):
+			if r.FormValue("locale_id") != "locale-id" || r.FormValue("file_format") != "yml" {
+				t.Errorf("unexpected upload of locale %q in format %q", r.FormValue("locale_id"), r.FormValue("file_format"))
+			}
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"id":"upload-id","filename":"de.yml","state":"`+state+`","summary":{"translations_created":1}}`)
+		case r.Method == "DELETE" && strings.HasSuffix(r.URL.Path, "/locales/locale-id"):
+			deleted = "locale-id"
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+	client := newTestClient(srv.URL)
+
+	if seed, err := newLocaleSeed(client, "", "", "", ""); seed != nil || err != nil {
+		t.Errorf("expected no seed without file, got %v (%v)", seed, err)
+	}
+	if _, err := newLocaleSeed(client, filepath.Join(dir, "missing.yml"), "", "", ""); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+
+	seed, err := newLocaleSeed(client, path, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seed.format != "yml" {
+		t.Errorf("expected the format to be detected, got %q", seed.format)
+	}
+
+	locale := &phraseapp.LocaleDetails{Locale: phraseapp.Locale{ID: "locale-id", Name: "de"}}
+	upload, err := seed.upload(client, "project-id", locale)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upload.Summary.TranslationsCreated != 1 || deleted != "" {
+		t.Errorf("expected the processed upload and the locale to be kept, got %+v (deleted %q)", upload, deleted)
+	}
+
+	state = "error"
+	if _, err := seed.upload(client, "project-id", locale); err == nil || !strings.Contains(err.Error(), "deleted the created locale de again") {
+		t.Errorf("expected an error reporting the rollback, got %v", err)
+	}
+	if deleted != "locale-id" {
+		t.Errorf("expected the locale to be deleted after the failed upload")
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// parseFileMode parses an octal file mode like 0644. Empty means
+// localeFileMode.
+func parseFileMode(value string) (os.FileMode, error) {
+	if value == "" {
+		return localeFileMode, nil
+	}
+	mode, err := strconv.ParseUint(value, 8, 32)
+	if err != nil || mode > 0777 {
+		return 0, fmt.Errorf("%q is no octal file mode like 0644", value)
+	}
+	return os.FileMode(mode), nil
+}
+
+// writeLocaleFile writes the downloaded content to path like pull does: the
+// bytes as they are, creating missing directories and replacing the file at
+// once.
+func writeLocaleFile(path string, content []byte, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), localeDirMode); err != nil {
+		return err
+	}
+	return writeFileAtomic(path, content, mode)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseFileMode(t *testing.T) {
+	for value, expected := range map[string]os.FileMode{"": localeFileMode, "0644": 0644, "600": 0600} {
+		mode, err := parseFileMode(value)
+		if err != nil || mode != expected {
+			t.Errorf("%q: expected %o, got %o (%v)", value, expected, mode, err)
+		}
+	}
+	for _, value := range []string{"rw-r--r--", "0999", "01777"} {
+		if _, err := parseFileMode(value); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+}
+
+func TestWriteLocaleFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp-locale-download-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "locales", "de", "messages.mo")
+	content := []byte("\xde\x12\x04\x95\x00\x00")
+	if err := writeLocaleFile(path, content, 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	written, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(written) != string(content) {
+		t.Errorf("expected the content to be written as it is, got %q", written)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0640 {
+		t.Errorf("expected mode 0640, got %v", info.Mode())
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// LocalesDownloadAllCommand downloads every locale of a project into a
+// directory, without a pull target in the configuration.
+type LocalesDownloadAllCommand struct {
+	*phraseapp.Config
+
+	phraseapp.LocaleDownloadParams
+
+	Dir string `cli:"opt --dir default=. desc='directory to write the locales to'"`
+
+	ProjectID string `cli:"arg required"`
+}
+
+func newLocalesDownloadAll(cfg *phraseapp.Config) (*LocalesDownloadAllCommand, error) {
+	cmd := &LocalesDownloadAllCommand{Config: cfg}
+	cmd.ProjectID = cfg.DefaultProjectID
+	if cfg.DefaultFileFormat != "" {
+		cmd.FileFormat = &cfg.DefaultFileFormat
+	}
+
+	if val, found := cmd.Config.Defaults["locales/download/all"]; found {
+		if err := cmd.ApplyValuesFromMap(val); err != nil {
+			return nil, err
+		}
+	}
+	return cmd, nil
+}
+
+func (cmd *LocalesDownloadAllCommand) Run() error {
+	return cmd.RunContext(commandContext)
+}
+
+// RunContext downloads the locales, ctx being done aborts the requests in
+// flight.
+func (cmd *LocalesDownloadAllCommand) RunContext(ctx context.Context) error {
+	if cmd.FileFormat == nil || *cmd.FileFormat == "" {
+		return fmt.Errorf("no file format given, please set --file-format")
+	}
+
+	clients := newClientPool(ctx, cmd.Config.Credentials)
+	client, err := clients.Client("")
+	if err != nil {
+		return err
+	}
+
+	extension := *cmd.FileFormat
+	format, err := FindFormat(client, *cmd.FileFormat)
+	if err != nil {
+		return err
+	}
+	if format != nil && format.Extension != "" {
+		extension = format.Extension
+	}
+
+	// Pulling a target built from the options reuses the download logic of
+	// pull, including the creation of missing directories.
+	target := &Target{
+		File:       filepath.Join(cmd.Dir, "<locale_code>."+extension),
+		ProjectID:  cmd.ProjectID,
+		FileFormat: *cmd.FileFormat,
+		Params:     &PullParams{LocaleDownloadParams: cmd.LocaleDownloadParams},
+	}
+	return target.Pull(clients, LocaleCache{})
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestLocalesDownloadAll(t *testing.T) {
+	defer func() { Quiet = false }()
+	Quiet = true
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/formats", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `[{"api_name": "simple_json", "extension": "json"}]`)
+	})
+	mux.HandleFunc("/v2/projects/project-id/locales", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `[{"id": "en-id", "code": "en", "name": "english"}, {"id": "de-id", "code": "de", "name": "german"}]`)
+	})
+	mux.HandleFunc("/v2/projects/project-id/locales/", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"path": "`+r.URL.Path+`"}`)
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	dir, err := ioutil.TempDir("", "phraseapp-download-all")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cmd, err := newLocalesDownloadAll(&phraseapp.Config{Credentials: &phraseapp.Credentials{Host: s.URL, Token: "some_token"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	format := "simple_json"
+	cmd.FileFormat = &format
+	cmd.Dir = filepath.Join(dir, "locales")
+	cmd.ProjectID = "project-id"
+
+	if err := cmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	for code, id := range map[string]string{"en": "en-id", "de": "de-id"} {
+		content, err := ioutil.ReadFile(filepath.Join(dir, "locales", code+".json"))
+		if err != nil {
+			t.Errorf("expected locale %s to be downloaded: %s", code, err)
+			continue
+		}
+		if expected := `{"path": "/v2/projects/project-id/locales/` + id + `/download"}`; string(content) != expected {
+			t.Errorf("expected content %s, got %s", expected, content)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// listLocales returns the locales of the given page, or of all pages if all
+// is set.
+func listLocales(client *phraseapp.Client, projectID string, page, perPage int, all bool) ([]*phraseapp.Locale, error) {
+	if !all {
+		return client.LocalesList(projectID, page, perPage)
+	}
+
+	locales := []*phraseapp.Locale{}
+	for page := 1; ; page++ {
+		res, err := client.LocalesList(projectID, page, maxPerPage)
+		if err != nil {
+			return nil, err
+		}
+		locales = append(locales, res...)
+		if len(res) < maxPerPage {
+			return locales, nil
+		}
+	}
+}
+
+// localeCompleteness fetches the statistics of every locale, which the list
+// of locales doesn't include.
+func localeCompleteness(client *phraseapp.Client, projectID string, locales []*phraseapp.Locale) ([]*phraseapp.LocaleDetails, error) {
+	details := []*phraseapp.LocaleDetails{}
+	for _, locale := range locales {
+		res, err := client.LocaleShow(projectID, locale.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the statistics of locale %s: %s", locale.Name, err)
+		}
+		details = append(details, res)
+	}
+	return details, nil
+}
+
+// printCompleteness prints a table of the share of translated and verified
+// keys per locale.
+func printCompleteness(w io.Writer, details []*phraseapp.LocaleDetails) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "CODE\tNAME\tTRANSLATED\tVERIFIED")
+	for _, locale := range details {
+		translated, verified := "-", "-"
+		if stats := locale.Statistics; stats != nil {
+			translated = percentage(stats.TranslationsCompletedCount, stats.KeysTotalCount)
+			verified = percentage(stats.TranslationsCompletedCount-stats.TranslationsUnverifiedCount, stats.KeysTotalCount)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", locale.Code, locale.Name, translated, verified)
+	}
+	return tw.Flush()
+}
+
+// percentage formats count of total as percentage, rounded down so only
+// complete locales show 100%. A project without keys is complete.
+func percentage(count, total int64) string {
+	if total <= 0 {
+		return "100%"
+	}
+	if count < 0 {
+		count = 0
+	}
+	return fmt.Sprintf("%d%%", count*100/total)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestListAllLocales(t *testing.T) {
+	total := maxPerPage + 1
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		first := 0
+		if r.URL.Query().Get("page") == "2" {
+			first = maxPerPage
+		}
+		locales := []map[string]string{}
+		for i := first; i < total && i < first+maxPerPage; i++ {
+			locales = append(locales, map[string]string{"id": fmt.Sprintf("locale-%d", i)})
+		}
+		json.NewEncoder(w).Encode(locales)
+	}))
+	defer s.Close()
+
+	locales, err := listLocales(newTestClient(s.URL), "project-id", 1, 25, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(locales) != total {
+		t.Errorf("expected %d locales, got %d", total, len(locales))
+	}
+}
+
+func TestLocaleCompleteness(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/projects/project-id/locales/de-locale-id" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"id": "de-locale-id", "code": "de", "name": "german", "statistics": {"keys_total_count": 3, "translations_completed_count": 2, "translations_unverified_count": 1}}`))
+	}))
+	defer s.Close()
+
+	locales := []*phraseapp.Locale{{ID: "de-locale-id"}}
+	details, err := localeCompleteness(newTestClient(s.URL), "project-id", locales)
+	if err != nil {
+		t.Fatal(err)
+	}
+	details = append(details, &phraseapp.LocaleDetails{Locale: phraseapp.Locale{Code: "fr", Name: "french"}})
+
+	buf := &bytes.Buffer{}
+	if err := printCompleteness(buf, details); err != nil {
+		t.Fatal(err)
+	}
+	expected := strings.Join([]string{
+		"CODE  NAME    TRANSLATED  VERIFIED",
+		"de    german  66%         33%",
+		"fr    french  -           -",
+		"",
+	}, "\n")
+	if buf.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, buf.String())
+	}
+
+	if _, err := localeCompleteness(newTestClient(s.URL), "project-id", []*phraseapp.Locale{{ID: "unknown"}}); err == nil {
+		t.Errorf("expected an error for a locale without statistics")
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const lockName = ".phraseapp.lock"
+
+const (
+	defaultLockTimeout = 30 * time.Second
+	lockRetryInterval  = 200 * time.Millisecond
+)
+
+// pullLock is an advisory lock held by pull while it writes files, so pulls
+// of the same configuration don't interleave their writes. It's a file only
+// existing while the lock is held, containing the process ID and start time
+// of the holder.
+type pullLock struct {
+	path string
+}
+
+func lockPath() string {
+	return filepath.Join(configDir(), lockName)
+}
+
+func parseLockTimeout(value string) (time.Duration, error) {
+	if value == "" {
+		return defaultLockTimeout, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid value %q for --lock-timeout, expected a duration like 30s", value)
+	}
+	return d, nil
+}
+
+// acquireLock creates the lock file at path. While another process holds the
+// lock, it retries until timeout has passed or ctx is done.
+func acquireLock(ctx context.Context, path string, timeout time.Duration) (*pullLock, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err == nil {
+			fmt.Fprintf(f, "%d %s\n", os.Getpid(), time.Now().UTC().Format(time.RFC3339))
+			if err := f.Close(); err != nil {
+				os.Remove(path)
+				return nil, err
+			}
+			return &pullLock{path: path}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("failed to create lock file: %s", err)
+		}
+
+		if !time.Now().Before(deadline) {
+			return nil, fmt.Errorf("%s is locked by another pull (%s), gave up after %s. Remove the file if no pull is running, or use --no-lock", path, lockHolder(path), timeout)
+		}
+		logger.Debugf("waiting for lock %s held by %s", path, lockHolder(path))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(lockRetryInterval):
+		}
+	}
+}
+
+// lockHolder describes the process holding the lock at path from the content
+// of the lock file.
+func lockHolder(path string) string {
+	content, err := ioutil.ReadFile(path)
+	fields := strings.Fields(string(content))
+	if err != nil || len(fields) != 2 {
+		return "unknown process"
+	}
+	return fmt.Sprintf("process %s since %s", fields[0], fields[1])
+}
+
+// Release removes the lock file.
+func (lock *pullLock) Release() error {
+	if lock == nil {
+		return nil
+	}
+	return os.Remove(lock.path)
+}
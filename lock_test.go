@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAcquireLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp-lock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, lockName)
+
+	lock, err := acquireLock(context.Background(), path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if holder := lockHolder(path); !strings.HasPrefix(holder, "process ") {
+		t.Errorf("expected the lock file to name the process, got %q", holder)
+	}
+
+	started := time.Now()
+	if _, err := acquireLock(context.Background(), path, 3*lockRetryInterval); err == nil || !strings.Contains(err.Error(), "--no-lock") {
+		t.Errorf("expected an error for a held lock, got %v", err)
+	}
+	if waited := time.Since(started); waited < 3*lockRetryInterval {
+		t.Errorf("expected to wait for the lock, gave up after %s", waited)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := acquireLock(ctx, path, time.Minute); err != context.Canceled {
+		t.Errorf("expected waiting to stop with the context, got %v", err)
+	}
+
+	if err := lock.Release(); err != nil {
+		t.Fatal(err)
+	}
+	lock, err = acquireLock(context.Background(), path, 0)
+	if err != nil {
+		t.Fatalf("expected the released lock to be acquired again, got %s", err)
+	}
+	lock.Release()
+}
+
+func TestParseLockTimeout(t *testing.T) {
+	if d, err := parseLockTimeout(""); err != nil || d != defaultLockTimeout {
+		t.Errorf("expected the default timeout, got %s (%v)", d, err)
+	}
+	if d, err := parseLockTimeout("2m"); err != nil || d != 2*time.Minute {
+		t.Errorf("expected 2m, got %s (%v)", d, err)
+	}
+	for _, value := range []string{"soon", "-1s"} {
+		if _, err := parseLockTimeout(value); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+}
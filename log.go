@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+type logLevel int
+
+const (
+	levelError logLevel = iota
+	levelWarn
+	levelInfo
+	levelDebug
+)
+
+var logLevelNames = []string{"error", "warn", "info", "debug"}
+
+func (level logLevel) String() string {
+	return logLevelNames[level]
+}
+
+func parseLogLevel(name string) (logLevel, error) {
+	for i, n := range logLevelNames {
+		if strings.EqualFold(n, name) {
+			return logLevel(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown log level %q, expected one of %s", name, strings.Join(logLevelNames, ", "))
+}
+
+// leveledLogger writes timestamped log messages to stderr, so they don't mix
+// with data written to stdout.
+type leveledLogger struct {
+	out   io.Writer
+	level logLevel
+	now   func() time.Time
+}
+
+var logger = &leveledLogger{out: os.Stderr, level: levelInfo, now: time.Now}
+
+// enabled reports whether messages of level are logged. Debug (set by the
+// --verbose option) enables debug messages and --quiet disables info messages
+// regardless of the log level.
+func (l *leveledLogger) enabled(level logLevel) bool {
+	switch {
+	case level == levelDebug && Debug:
+		return true
+	case level == levelInfo && Quiet:
+		return false
+	}
+	return level <= l.level
+}
+
+func (l *leveledLogger) logf(level logLevel, format string, args ...interface{}) {
+	if !l.enabled(level) {
+		return
+	}
+	fmt.Fprintf(l.out, "%s %-5s %s\n", l.now().Format("2006-01-02 15:04:05"), strings.ToUpper(level.String()), redact(fmt.Sprintf(format, args...)))
+}
+
+func (l *leveledLogger) Errorf(format string, args ...interface{}) {
+	l.logf(levelError, format, args...)
+}
+
+func (l *leveledLogger) Warnf(format string, args ...interface{}) {
+	l.logf(levelWarn, format, args...)
+}
+
+func (l *leveledLogger) Infof(format string, args ...interface{}) {
+	l.logf(levelInfo, format, args...)
+}
+
+func (l *leveledLogger) Debugf(format string, args ...interface{}) {
+	l.logf(levelDebug, format, args...)
+}
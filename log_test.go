@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLeveledLogger(t *testing.T) {
+	defer func() { Debug, Quiet = false, false }()
+
+	out := &bytes.Buffer{}
+	l := &leveledLogger{out: out, level: levelWarn, now: func() time.Time {
+		return time.Date(2016, 3, 1, 12, 30, 0, 0, time.UTC)
+	}}
+
+	l.Errorf("failed %d", 1)
+	l.Warnf("careful")
+	l.Infof("hidden")
+	l.Debugf("hidden")
+	expected := "2016-03-01 12:30:00 ERROR failed 1\n2016-03-01 12:30:00 WARN  careful\n"
+	if out.String() != expected {
+		t.Errorf("expected output %q, got %q", expected, out.String())
+	}
+
+	out.Reset()
+	Debug = true
+	l.Debugf("shown")
+	if !strings.HasSuffix(out.String(), "DEBUG shown\n") {
+		t.Errorf("expected Debug to enable debug messages, got %q", out.String())
+	}
+
+	out.Reset()
+	l.level, Quiet = levelDebug, true
+	l.Infof("hidden")
+	if out.Len() != 0 {
+		t.Errorf("expected --quiet to suppress info messages, got %q", out.String())
+	}
+}
+
+func TestParseLogLevel(t *testing.T) {
+	for name, expected := range map[string]logLevel{"error": levelError, "WARN": levelWarn, "info": levelInfo, "debug": levelDebug} {
+		level, err := parseLogLevel(name)
+		if err != nil {
+			t.Errorf("%s: %s", name, err)
+		} else if level != expected {
+			t.Errorf("%s: expected level %s, got %s", name, expected, level)
+		}
+	}
+
+	if _, err := parseLogLevel("verbose"); err == nil {
+		t.Errorf("expected an error for an unknown level")
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"os"
 
 	"fmt"
@@ -27,28 +28,73 @@ func Run() {
 		}
 	}()
 
+	args, err := extractGlobalOptions(os.Args[1:])
+	reportCommand = commandName(args)
+	if err == nil {
+		err = checkHeaders()
+	}
+	if err == nil {
+		err = checkPerPageArgs(args)
+	}
+	if err == nil {
+		args, err = rewriteFormatOptionArgs(args)
+	}
+	if err != nil {
+		printErr(err)
+		os.Exit(exitInvalid)
+	}
+
 	phraseapp.ClientVersion = PHRASEAPP_CLIENT_VERSION
-	ValidateVersion()
+	var versionNotice <-chan error
+	if !Quiet && os.Getenv("PHRASEAPP_NO_UPDATE_CHECK") == "" {
+		versionNotice = checkVersionInBackground()
+	}
 
-	cfg, err := phraseapp.ReadConfig()
+	cfg, err = ReadConfig()
+	if err == nil && StdinYAML {
+		overrides, err = readOverrides(os.Stdin, cfg)
+	}
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
-		os.Exit(2)
+		fmt.Fprintf(os.Stderr, "Error: %s\n", redact(err.Error()))
+		os.Exit(exitInvalid)
+	}
+
+	if cfg.Credentials != nil {
+		addSecret(cfg.Token)
 	}
 
 	r, err := router(cfg)
 	if err != nil {
 		printErr(err)
-		os.Exit(3)
+		os.Exit(exitInvalid)
+	}
+
+	ctx, cancel := newCommandContext(Timeout)
+	commandContext = ctx
+	handleInterrupts(cancel)
+	err = r.Run(args...)
+	if err != nil && isInterrupted() {
+		exitInterrupted()
+	}
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		printErr(fmt.Errorf("command timed out after %s", Timeout))
+		os.Exit(exitNetwork)
+	}
+	cancel()
+	if err == nil {
+		err = checkStrict()
 	}
 
-	switch err := r.RunWithArgs(); err {
+	code := exitOK
+	switch err {
 	case cli.ErrorHelpRequested, cli.ErrorNoRoute:
-		os.Exit(1)
+		fmt.Fprintln(os.Stderr, globalOptionsHelp())
+		code = exitError
 	case nil:
-		os.Exit(0)
 	default:
 		printErr(err)
-		os.Exit(1)
+		code = exitCode(err)
 	}
+	printVersionNotice(versionNotice)
+	os.Exit(code)
 }
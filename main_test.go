@@ -321,3 +321,27 @@ func TestCLIHelp_FormatOptions(t *testing.T) {
 	// FormatOptions are ignored with regard to defaults!
 	matchDefaultExpectations(t, defaults, map[string]string{})
 }
+
+func TestIsBinaryFormat(t *testing.T) {
+	for _, tc := range []struct {
+		format   *phraseapp.Format
+		expected bool
+	}{
+		{&phraseapp.Format{ApiName: "xlsx", Extension: "xlsx"}, true},
+		{&phraseapp.Format{ApiName: "strings", Extension: "strings", DefaultEncoding: "UTF-16"}, true},
+		{&phraseapp.Format{ApiName: "yml", Extension: "yml", DefaultEncoding: "UTF-8"}, false},
+		{nil, false},
+	} {
+		if got := IsBinaryFormat(tc.format); got != tc.expected {
+			t.Errorf("%#v: expected binary to be %t, got %t", tc.format, tc.expected, got)
+		}
+	}
+}
+
+func newTestClient(host string) *phraseapp.Client {
+	c := new(phraseapp.Client)
+	c.Credentials = new(phraseapp.Credentials)
+	c.Credentials.Host = host
+	c.Credentials.Token = "some_token"
+	return c
+}
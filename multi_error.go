@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// fileError is the failure to pull or push a single file.
+type fileError struct {
+	path   string
+	locale string
+	err    error
+}
+
+func (e *fileError) String() string {
+	if e.locale == "" {
+		return fmt.Sprintf("%s: %s", e.path, e.err)
+	}
+	return fmt.Sprintf("%s (%s): %s", e.path, e.locale, e.err)
+}
+
+// MultiError collects the files pull or push failed for, so a single failing
+// file doesn't stop the transfer of the others.
+type MultiError struct {
+	// Total is the number of files pull or push tried to transfer.
+	Total  int
+	Errors []*fileError
+}
+
+// newFailures returns the MultiError collecting the files pull or push failed
+// for. With --fail-fast it returns nil, so the first failure stops the command.
+func newFailures(failFast, keepGoing bool) (*MultiError, error) {
+	switch {
+	case failFast && keepGoing:
+		return nil, &invalidError{fmt.Errorf("--fail-fast and --keep-going exclude each other")}
+	case failFast:
+		return nil, nil
+	}
+	return &MultiError{}, nil
+}
+
+// count adds n files to the total of files tried to transfer.
+func (e *MultiError) count(n int) {
+	if e != nil {
+		e.Total += n
+	}
+}
+
+// Add records err for localeFile. Without MultiError to collect it, the error
+// is returned to stop right away.
+func (e *MultiError) Add(localeFile *LocaleFile, err error) error {
+	if e == nil {
+		return fmt.Errorf("%s for %s", err, localeFile.Path)
+	}
+
+	locale := localeFile.Code
+	if locale == "" {
+		locale = localeFile.Name
+	}
+	e.Errors = append(e.Errors, &fileError{path: localeFile.Path, locale: locale, err: err})
+	return nil
+}
+
+// Err returns e if a file failed, nil otherwise.
+func (e *MultiError) Err() error {
+	if e == nil || len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Partial tells whether some files were transferred besides the failed ones.
+func (e *MultiError) Partial() bool {
+	return len(e.Errors) < e.Total
+}
+
+func (e *MultiError) Error() string {
+	lines := []string{fmt.Sprintf("%d of %d files failed:", len(e.Errors), e.Total)}
+	for _, fileErr := range e.Errors {
+		lines = append(lines, "  "+fileErr.String())
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMultiError(t *testing.T) {
+	failures := &MultiError{}
+	if err := failures.Err(); err != nil {
+		t.Errorf("expected no error without failures, got %v", err)
+	}
+
+	failures.count(3)
+	if err := failures.Add(&LocaleFile{Path: "de.yml", Code: "de"}, fmt.Errorf("500 - Internal Server Error")); err != nil {
+		t.Fatal(err)
+	}
+	if err := failures.Add(&LocaleFile{Path: "fr.yml", Name: "french"}, fmt.Errorf("timeout")); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "2 of 3 files failed:\n  de.yml (de): 500 - Internal Server Error\n  fr.yml (french): timeout"
+	if err := failures.Err(); err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+	if !failures.Partial() {
+		t.Errorf("expected one of three files failing to be partial")
+	}
+}
+
+func TestMultiErrorNil(t *testing.T) {
+	var failures *MultiError
+	failures.count(1)
+	err := failures.Add(&LocaleFile{Path: "de.yml"}, fmt.Errorf("500 - Internal Server Error"))
+	if err == nil || err.Error() != "500 - Internal Server Error for de.yml" {
+		t.Errorf("expected the error to be returned without MultiError, got %v", err)
+	}
+	if err := failures.Err(); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestNewFailures(t *testing.T) {
+	if failures, err := newFailures(false, false); err != nil || failures == nil {
+		t.Errorf("expected to keep going by default, got %v (%v)", failures, err)
+	}
+	if failures, err := newFailures(false, true); err != nil || failures == nil {
+		t.Errorf("expected to keep going with --keep-going, got %v (%v)", failures, err)
+	}
+	if failures, err := newFailures(true, false); err != nil || failures != nil {
+		t.Errorf("expected no MultiError with --fail-fast, got %v (%v)", failures, err)
+	}
+	if _, err := newFailures(true, true); err == nil {
+		t.Error("expected an error for --fail-fast with --keep-going")
+	} else if _, ok := err.(*invalidError); !ok {
+		t.Errorf("expected an invalid usage error, got %T", err)
+	}
+}
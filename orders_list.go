@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// listOrders returns the orders of the given page, or of all pages if all is
+// set.
+func listOrders(client *phraseapp.Client, projectID string, page, perPage int, all bool) ([]*phraseapp.TranslationOrder, error) {
+	if !all {
+		return client.OrdersList(projectID, page, perPage)
+	}
+
+	orders := []*phraseapp.TranslationOrder{}
+	for page := 1; ; page++ {
+		res, err := client.OrdersList(projectID, page, maxPerPage)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, res...)
+		if len(res) < maxPerPage {
+			return orders, nil
+		}
+	}
+}
+
+// filterOrders returns the orders in one of the given states (all if there are
+// none), compared case-insensitively.
+func filterOrders(orders []*phraseapp.TranslationOrder, states []string) []*phraseapp.TranslationOrder {
+	if len(states) == 0 {
+		return orders
+	}
+
+	filtered := []*phraseapp.TranslationOrder{}
+	for _, order := range orders {
+		for _, state := range states {
+			if strings.EqualFold(order.State, state) {
+				filtered = append(filtered, order)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// ordersSummary describes how many of the orders matched the states.
+func ordersSummary(matched, total int, states []string) string {
+	if len(states) == 0 {
+		return fmt.Sprintf("%d orders", total)
+	}
+	return fmt.Sprintf("%d of %d orders with status %s", matched, total, strings.Join(states, ", "))
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestListAllOrders(t *testing.T) {
+	total := maxPerPage + 2
+	pages := []string{}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page+"/"+r.URL.Query().Get("per_page"))
+
+		orders := []map[string]string{}
+		first := 0
+		if page == "2" {
+			first = maxPerPage
+		}
+		for i := first; i < total && i < first+maxPerPage; i++ {
+			orders = append(orders, map[string]string{"id": fmt.Sprintf("order-%d", i), "state": "confirmed"})
+		}
+		json.NewEncoder(w).Encode(orders)
+	}))
+	defer s.Close()
+
+	orders, err := listOrders(newTestClient(s.URL), "project-id", 3, 10, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != total {
+		t.Errorf("expected %d orders, got %d", total, len(orders))
+	}
+	if expected := "1/100,2/100"; strings.Join(pages, ",") != expected {
+		t.Errorf("expected pages %s to be requested, got %v", expected, pages)
+	}
+}
+
+func TestFilterOrders(t *testing.T) {
+	orders := []*phraseapp.TranslationOrder{
+		{ID: "1", State: "confirmed"},
+		{ID: "2", State: "in_progress"},
+		{ID: "3", State: "completed"},
+	}
+
+	if res := filterOrders(orders, nil); len(res) != 3 {
+		t.Errorf("expected all orders without a status, got %d", len(res))
+	}
+
+	res := filterOrders(orders, []string{"Confirmed", "completed"})
+	if len(res) != 2 || res[0].ID != "1" || res[1].ID != "3" {
+		t.Errorf("expected orders 1 and 3, got %v", res)
+	}
+
+	if summary := ordersSummary(len(res), len(orders), []string{"confirmed", "completed"}); summary != "2 of 3 orders with status confirmed, completed" {
+		t.Errorf("unexpected summary %q", summary)
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/daviddengcn/go-colortext"
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// Pretty indents JSON output and colorizes it on a terminal.
+var Pretty bool
+
+// printJSON writes v as JSON to stdout. With --summary-only resources with
+// summary fields are printed as summary instead, see writeSummary.
+func printJSON(v interface{}) error {
+	if SummaryOnly {
+		if printed, err := writeSummary(os.Stdout, v); printed || err != nil {
+			return err
+		}
+	}
+
+	if !Pretty {
+		return json.NewEncoder(os.Stdout).Encode(v)
+	}
+
+	content, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if !colorOutput() {
+		_, err = fmt.Println(string(content))
+		return err
+	}
+
+	colorizeJSON(content, func(text string, color ct.Color) {
+		if color == ct.None {
+			fmt.Print(text)
+			return
+		}
+		ct.Foreground(color, false)
+		fmt.Print(text)
+		ct.ResetColor()
+	})
+	fmt.Println()
+	return nil
+}
+
+// printKeyNames writes the name of every key as a line to w.
+func printKeyNames(w io.Writer, keys []*phraseapp.TranslationKey) {
+	for _, key := range keys {
+		fmt.Fprintln(w, key.Name)
+	}
+}
+
+// checkEmpty returns an error for an empty result if failOnEmpty is set.
+func checkEmpty(failOnEmpty bool, count int) error {
+	if failOnEmpty && count == 0 {
+		return fmt.Errorf("no results found")
+	}
+	return nil
+}
+
+// colorOutput reports whether stdout should be colorized. See
+// http://no-color.org for NO_COLOR.
+func colorOutput() bool {
+	return os.Getenv("NO_COLOR") == "" && isTerminal(os.Stdout)
+}
+
+// colorizeJSON splits the JSON document into tokens and hands each one to emit
+// with the color it's printed in. Keys, strings, numbers and the literals true,
+// false and null are colored, everything else is emitted with ct.None.
+func colorizeJSON(content []byte, emit func(text string, color ct.Color)) {
+	for i := 0; i < len(content); {
+		start := i
+		switch c := content[i]; {
+		case c == '"':
+			for i++; i < len(content) && content[i] != '"'; i++ {
+				if content[i] == '\\' {
+					i++
+				}
+			}
+			i++
+			if i > len(content) {
+				i = len(content)
+			}
+			color := ct.Green
+			if isJSONKey(content[i:]) {
+				color = ct.Cyan
+			}
+			emit(string(content[start:i]), color)
+		case c == '-' || (c >= '0' && c <= '9'):
+			for i++; i < len(content) && isJSONNumberByte(content[i]); i++ {
+			}
+			emit(string(content[start:i]), ct.Yellow)
+		case c >= 'a' && c <= 'z':
+			for i++; i < len(content) && content[i] >= 'a' && content[i] <= 'z'; i++ {
+			}
+			emit(string(content[start:i]), ct.Magenta)
+		default:
+			for i++; i < len(content) && !isJSONTokenStart(content[i]); i++ {
+			}
+			emit(string(content[start:i]), ct.None)
+		}
+	}
+}
+
+// isJSONKey reports whether the string preceding rest is an object key.
+func isJSONKey(rest []byte) bool {
+	for _, c := range rest {
+		switch c {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case ':':
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+func isJSONNumberByte(c byte) bool {
+	return (c >= '0' && c <= '9') || c == '.' || c == 'e' || c == 'E' || c == '+' || c == '-'
+}
+
+func isJSONTokenStart(c byte) bool {
+	return c == '"' || c == '-' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z')
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// SummaryOnly prints the summary fields of resources as "name: value" lines
+// instead of JSON (--summary-only).
+var SummaryOnly bool
+
+// A summaryField is printed with --summary-only as name, with the value of the
+// Go field at path. Nested fields are separated by dots, e.g. Key.Name.
+type summaryField struct {
+	name string
+	path string
+}
+
+// summaryFields are the fields --summary-only prints for each type of
+// resource. Types embedding one of them, like ProjectDetails, use the fields of
+// the embedded type unless they define their own.
+var summaryFields = map[reflect.Type][]summaryField{
+	reflect.TypeOf(phraseapp.Authorization{}): {
+		{"id", "ID"}, {"note", "Note"}, {"token_last_eight", "TokenLastEight"}, {"scopes", "Scopes"}, {"expires_at", "ExpiresAt"},
+	},
+	reflect.TypeOf(phraseapp.BlacklistedKey{}): {
+		{"id", "ID"}, {"name", "Name"},
+	},
+	reflect.TypeOf(phraseapp.Comment{}): {
+		{"id", "ID"}, {"user", "User.Username"}, {"message", "Message"},
+	},
+	reflect.TypeOf(phraseapp.Format{}): {
+		{"api_name", "ApiName"}, {"name", "Name"}, {"extension", "Extension"},
+	},
+	reflect.TypeOf(phraseapp.Locale{}): {
+		{"id", "ID"}, {"name", "Name"}, {"code", "Code"}, {"default", "Default"},
+	},
+	reflect.TypeOf(phraseapp.LocaleDetails{}): {
+		{"id", "ID"}, {"name", "Name"}, {"code", "Code"}, {"default", "Default"},
+		{"keys_total_count", "Statistics.KeysTotalCount"},
+		{"keys_untranslated_count", "Statistics.KeysUntranslatedCount"},
+		{"translations_unverified_count", "Statistics.TranslationsUnverifiedCount"},
+	},
+	reflect.TypeOf(phraseapp.Project{}): {
+		{"id", "ID"}, {"name", "Name"}, {"main_format", "MainFormat"}, {"updated_at", "UpdatedAt"},
+	},
+	reflect.TypeOf(phraseapp.Styleguide{}): {
+		{"id", "ID"}, {"title", "Title"},
+	},
+	reflect.TypeOf(phraseapp.Tag{}): {
+		{"name", "Name"}, {"keys_count", "KeysCount"},
+	},
+	reflect.TypeOf(phraseapp.Translation{}): {
+		{"id", "ID"}, {"key", "Key.Name"}, {"locale", "Locale.Code"}, {"unverified", "Unverified"}, {"content", "Content"},
+	},
+	reflect.TypeOf(phraseapp.TranslationKey{}): {
+		{"id", "ID"}, {"name", "Name"}, {"tags", "Tags"},
+	},
+	reflect.TypeOf(phraseapp.TranslationKeyDetails{}): {
+		{"id", "ID"}, {"name", "Name"}, {"tags", "Tags"}, {"comments_count", "CommentsCount"},
+	},
+	reflect.TypeOf(phraseapp.TranslationOrder{}): {
+		{"id", "ID"}, {"state", "State"}, {"lsp", "Lsp"}, {"progress_percent", "ProgressPercent"},
+	},
+	reflect.TypeOf(phraseapp.TranslationVersion{}): {
+		{"id", "ID"}, {"key", "Key.Name"}, {"locale", "Locale.Code"}, {"changed_at", "ChangedAt"},
+	},
+	reflect.TypeOf(phraseapp.Upload{}): {
+		{"id", "ID"}, {"filename", "Filename"}, {"state", "State"},
+		{"translation_keys_created", "Summary.TranslationKeysCreated"},
+		{"translations_created", "Summary.TranslationsCreated"},
+		{"translations_updated", "Summary.TranslationsUpdated"},
+	},
+	reflect.TypeOf(phraseapp.Webhook{}): {
+		{"id", "ID"}, {"callback_url", "CallbackUrl"}, {"active", "Active"},
+	},
+}
+
+// writeSummary writes the summary of v to w, or of every item if v is a list,
+// separated by empty lines. Returns false if the type of v has no summary
+// fields, so it's printed as JSON instead.
+func writeSummary(w io.Writer, v interface{}) (bool, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	items := []reflect.Value{value}
+	itemType := value.Type()
+	if value.Kind() == reflect.Slice {
+		itemType = itemType.Elem()
+		items = items[:0]
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, value.Index(i))
+		}
+	}
+
+	fields := summaryFieldsOf(itemType)
+	if fields == nil {
+		return false, nil
+	}
+
+	buf := &bytes.Buffer{}
+	for i, item := range items {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		for _, field := range fields {
+			fmt.Fprintf(buf, "%s: %s\n", field.name, summaryValue(item, field.path))
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return true, err
+}
+
+// summaryFieldsOf returns the summary fields of t, or of the type it embeds
+// first. Returns nil if there are none.
+func summaryFieldsOf(t reflect.Type) []summaryField {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if fields, found := summaryFields[t]; found {
+		return fields
+	}
+	if t.Kind() == reflect.Struct && t.NumField() > 0 && t.Field(0).Anonymous {
+		return summaryFieldsOf(t.Field(0).Type)
+	}
+	return nil
+}
+
+// summaryValue formats the field at path of item. Missing values, e.g. behind
+// a nil pointer, are empty.
+func summaryValue(item reflect.Value, path string) string {
+	value := item
+	for _, name := range strings.Split(path, ".") {
+		for value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				return ""
+			}
+			value = value.Elem()
+		}
+		value = value.FieldByName(name)
+		if !value.IsValid() {
+			return ""
+		}
+	}
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+
+	switch v := value.Interface().(type) {
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case []string:
+		return strings.Join(v, ",")
+	}
+	return fmt.Sprint(value.Interface())
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestWriteSummary(t *testing.T) {
+	updated := time.Date(2016, 1, 2, 15, 4, 5, 0, time.UTC)
+	project := &phraseapp.ProjectDetails{Project: phraseapp.Project{ID: "abc", Name: "Website", MainFormat: "yml", UpdatedAt: &updated}}
+
+	out := &bytes.Buffer{}
+	if printed, err := writeSummary(out, project); err != nil || !printed {
+		t.Fatalf("expected a summary, got %t (%v)", printed, err)
+	}
+	expected := "id: abc\nname: Website\nmain_format: yml\nupdated_at: 2016-01-02T15:04:05Z\n"
+	if out.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, out)
+	}
+
+	translations := []*phraseapp.Translation{
+		{ID: "1", Key: &phraseapp.KeyPreview{Name: "home.title"}, Locale: &phraseapp.LocalePreview{Code: "de"}, Content: "Hallo"},
+		{ID: "2", Unverified: true},
+	}
+	out.Reset()
+	if _, err := writeSummary(out, &translations); err != nil {
+		t.Fatal(err)
+	}
+	expected = "id: 1\nkey: home.title\nlocale: de\nunverified: false\ncontent: Hallo\n\n" +
+		"id: 2\nkey: \nlocale: \nunverified: true\ncontent: \n"
+	if out.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, out)
+	}
+
+	out.Reset()
+	if printed, err := writeSummary(out, &phraseapp.AffectedResources{RecordsAffected: 1}); err != nil || printed || out.Len() > 0 {
+		t.Errorf("expected no summary for types without summary fields, got %t (%v) %q", printed, err, out)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// parseOutputTemplate parses the --template of list and show commands. It's
+// called before any request is sent, so a broken template fails right away.
+// Returns nil without template.
+func parseOutputTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("--template").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, &invalidError{fmt.Errorf("invalid template: %s", err)}
+	}
+	return tmpl, nil
+}
+
+// printOutput prints v with tmpl, or as JSON without template.
+func printOutput(tmpl *template.Template, v interface{}) error {
+	if tmpl == nil {
+		return printJSON(v)
+	}
+
+	out, err := renderTemplate(tmpl, v)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
+
+// renderTemplate executes tmpl for every item if v is a list, or for v
+// otherwise, each followed by a newline. All items are rendered before any
+// output, so an error doesn't leave half the list printed.
+func renderTemplate(tmpl *template.Template, v interface{}) ([]byte, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	items := []reflect.Value{value}
+	if value.Kind() == reflect.Slice {
+		items = items[:0]
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, value.Index(i))
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	for _, item := range items {
+		if err := tmpl.Execute(buf, item.Interface()); err != nil {
+			return nil, &invalidError{fmt.Errorf("%s\nAvailable fields: %s", err, strings.Join(templateFields(item.Type()), ", "))}
+		}
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// templateFields returns the sorted names of the fields a template can use
+// for items of type t, including the ones of embedded structs.
+func templateFields(t reflect.Type) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		switch {
+		case field.Anonymous:
+			fields = append(fields, templateFields(field.Type)...)
+		case field.PkgPath == "":
+			fields = append(fields, "."+field.Name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestParseOutputTemplate(t *testing.T) {
+	tmpl, err := parseOutputTemplate("")
+	if tmpl != nil || err != nil {
+		t.Errorf("expected no template without --template, got %v (%v)", tmpl, err)
+	}
+	if _, err := parseOutputTemplate("{{.Name"); err == nil {
+		t.Errorf("expected an error for an unclosed action")
+	} else if _, ok := err.(*invalidError); !ok {
+		t.Errorf("expected an invalidError, got %T", err)
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	tmpl, err := parseOutputTemplate("{{.Code}} {{.Name}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locales := []*phraseapp.Locale{{Code: "en", Name: "english"}, {Code: "de", Name: "german"}}
+	out, err := renderTemplate(tmpl, &locales)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "en english\nde german\n"; string(out) != expected {
+		t.Errorf("expected %q, got %q", expected, out)
+	}
+
+	details := &phraseapp.LocaleDetails{Locale: phraseapp.Locale{Code: "fr", Name: "french"}}
+	out, err = renderTemplate(tmpl, &details)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "fr french\n"; string(out) != expected {
+		t.Errorf("expected %q, got %q", expected, out)
+	}
+}
+
+func TestRenderTemplateUnknownField(t *testing.T) {
+	tmpl, err := parseOutputTemplate("{{.Id}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := []*phraseapp.Tag{{Name: "feature"}}
+	out, err := renderTemplate(tmpl, &tags)
+	if err == nil {
+		t.Fatalf("expected an error for an unknown field, got %q", out)
+	}
+	if !strings.Contains(err.Error(), "Available fields: .CreatedAt, .KeysCount, .Name, .UpdatedAt") {
+		t.Errorf("expected the error to list the fields of tags, got %q", err)
+	}
+}
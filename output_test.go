@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/daviddengcn/go-colortext"
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestColorizeJSON(t *testing.T) {
+	content := "{\n  \"name\": \"say \\\"hi\\\"\",\n  \"count\": -2.5,\n  \"ok\": true,\n  \"tags\": null\n}"
+
+	colors := map[string]ct.Color{}
+	out := []string{}
+	colorizeJSON([]byte(content), func(text string, color ct.Color) {
+		out = append(out, text)
+		if color != ct.None {
+			colors[text] = color
+		}
+	})
+
+	if joined := strings.Join(out, ""); joined != content {
+		t.Errorf("expected content to be emitted unchanged, got %q", joined)
+	}
+
+	for text, expected := range map[string]ct.Color{
+		`"name"`:       ct.Cyan,
+		`"say \"hi\""`: ct.Green,
+		`"count"`:      ct.Cyan,
+		"-2.5":         ct.Yellow,
+		"true":         ct.Magenta,
+		"null":         ct.Magenta,
+	} {
+		if colors[text] != expected {
+			t.Errorf("expected %s to be colored %v, got %v", text, expected, colors[text])
+		}
+	}
+}
+
+func TestCheckEmpty(t *testing.T) {
+	if err := checkEmpty(true, 0); err == nil {
+		t.Errorf("expected an error for an empty result")
+	}
+	if err := checkEmpty(true, 3); err != nil {
+		t.Errorf("didn't expect an error for a result, got %s", err)
+	}
+	if err := checkEmpty(false, 0); err != nil {
+		t.Errorf("didn't expect an error without --fail-on-empty, got %s", err)
+	}
+}
+
+func TestPrintKeyNames(t *testing.T) {
+	out := &bytes.Buffer{}
+	printKeyNames(out, []*phraseapp.TranslationKey{
+		{Name: "home.title"},
+		{Name: "home.subtitle"},
+	})
+	if expected := "home.title\nhome.subtitle\n"; out.String() != expected {
+		t.Errorf("expected %q, got %q", expected, out.String())
+	}
+}
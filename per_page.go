@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxPerPage is the largest page size accepted by the API, larger values are
+// silently reduced by it.
+const maxPerPage = 100
+
+func validatePerPage(perPage int) error {
+	if perPage < 1 || perPage > maxPerPage {
+		return fmt.Errorf("per page must be between 1 and %d, got %d", maxPerPage, perPage)
+	}
+	return nil
+}
+
+// checkPerPageArgs validates the --per-page option of all list commands, so
+// it doesn't need to be done in each of the generated commands.
+func checkPerPageArgs(args []string) error {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return nil
+		}
+
+		var value string
+		switch {
+		case arg == "--per-page" && i+1 < len(args):
+			i++
+			value = args[i]
+		case strings.HasPrefix(arg, "--per-page="):
+			value = strings.TrimPrefix(arg, "--per-page=")
+		default:
+			continue
+		}
+
+		perPage, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid value %q for --per-page", value)
+		}
+		if err := validatePerPage(perPage); err != nil {
+			return fmt.Errorf("--%s", err)
+		}
+	}
+	return nil
+}
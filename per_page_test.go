@@ -0,0 +1,26 @@
+package main
+
+import "testing"
+
+func TestCheckPerPageArgs(t *testing.T) {
+	for _, args := range [][]string{
+		{"locales/list", "--per-page", "100"},
+		{"locales/list", "--per-page=1"},
+		{"key/create", "--", "--per-page", "500"},
+		{"locales/list"},
+	} {
+		if err := checkPerPageArgs(args); err != nil {
+			t.Errorf("expected %v to be valid, got %s", args, err)
+		}
+	}
+
+	for _, args := range [][]string{
+		{"locales/list", "--per-page", "101"},
+		{"locales/list", "--per-page=0"},
+		{"locales/list", "--per-page", "many"},
+	} {
+		if err := checkPerPageArgs(args); err == nil {
+			t.Errorf("expected %v to be invalid", args)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+const (
+	progressBarWidth = 30
+	progressInterval = 100 * time.Millisecond
+)
+
+var spinnerFrames = []string{"|", "/", "-", "\\"}
+
+// showProgress reports whether progress of downloads and uploads is shown.
+func showProgress() bool {
+	return !Quiet && isTerminal(os.Stdout)
+}
+
+// progress draws a bar of the bytes transferred out of total, or a spinner if
+// the total is unknown (negative).
+type progress struct {
+	out   io.Writer
+	label string
+	total int64
+
+	done     int64
+	frame    int
+	drawn    time.Time
+	finished bool
+	now      func() time.Time
+}
+
+func newProgress(out io.Writer, label string, total int64) *progress {
+	return &progress{out: out, label: label, total: total, now: time.Now}
+}
+
+func (p *progress) add(n int) {
+	p.done += int64(n)
+	if now := p.now(); now.Sub(p.drawn) >= progressInterval {
+		p.drawn = now
+		p.draw()
+	}
+}
+
+func (p *progress) draw() {
+	if p.total > 0 {
+		done := p.done
+		if done > p.total {
+			done = p.total
+		}
+		filled := int(done * progressBarWidth / p.total)
+		fmt.Fprintf(p.out, "\r%s [%s%s] %3d%% %s / %s", p.label,
+			strings.Repeat("=", filled), strings.Repeat(" ", progressBarWidth-filled),
+			done*100/p.total, formatBytes(done), formatBytes(p.total),
+		)
+		return
+	}
+	fmt.Fprintf(p.out, "\r%s %s %s", p.label, spinnerFrames[p.frame%len(spinnerFrames)], formatBytes(p.done))
+	p.frame++
+}
+
+// finish clears the line, so the next message replaces the progress.
+func (p *progress) finish() {
+	if p.finished {
+		return
+	}
+	p.finished = true
+	if !p.drawn.IsZero() {
+		fmt.Fprint(p.out, "\r\033[K")
+	}
+}
+
+// progressReader reports the bytes read from body to p.
+type progressReader struct {
+	body io.ReadCloser
+	p    *progress
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.body.Read(b)
+	r.p.add(n)
+	if err == io.EOF {
+		r.p.finish()
+	}
+	return n, err
+}
+
+func (r *progressReader) Close() error {
+	r.p.finish()
+	return r.body.Close()
+}
+
+// progressTransport shows the progress of the request body for uploads and of
+// the response body otherwise.
+type progressTransport struct {
+	next   http.RoundTripper
+	out    io.Writer
+	label  string
+	upload bool
+}
+
+func (t *progressTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.upload && req.Body != nil {
+		r := new(http.Request)
+		*r = *req
+		r.Body = &progressReader{body: req.Body, p: newProgress(t.out, t.label, progressTotal(req.ContentLength))}
+		req = r
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || t.upload {
+		return resp, err
+	}
+	resp.Body = &progressReader{body: resp.Body, p: newProgress(t.out, t.label, progressTotal(resp.ContentLength))}
+	return resp, nil
+}
+
+// progressTotal maps an unknown content length (0 or -1) to -1.
+func progressTotal(length int64) int64 {
+	if length <= 0 {
+		return -1
+	}
+	return length
+}
+
+// progressClient returns a copy of client showing the progress of its
+// downloads, or uploads if upload is set, on stdout.
+func progressClient(client *phraseapp.Client, label string, upload bool) *phraseapp.Client {
+	next := client.Client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	c := *client
+	c.Client.Transport = &progressTransport{next: next, out: os.Stdout, label: label, upload: upload}
+	return &c
+}
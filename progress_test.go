@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProgressBar(t *testing.T) {
+	out := &bytes.Buffer{}
+	now := time.Date(2016, 3, 1, 0, 0, 0, 0, time.UTC)
+	p := newProgress(out, "en.yml", 2048)
+	p.now = func() time.Time {
+		now = now.Add(progressInterval)
+		return now
+	}
+
+	p.add(1024)
+	if expected := "\ren.yml [===============               ]  50% 1.0 KB / 2.0 KB"; out.String() != expected {
+		t.Errorf("expected %q, got %q", expected, out.String())
+	}
+
+	out.Reset()
+	p.finish()
+	p.finish()
+	if expected := "\r\033[K"; out.String() != expected {
+		t.Errorf("expected the line to be cleared once, got %q", out.String())
+	}
+}
+
+func TestProgressSpinner(t *testing.T) {
+	out := &bytes.Buffer{}
+	p := newProgress(out, "en.yml", -1)
+	p.draw()
+	p.draw()
+	if expected := "\ren.yml | 0 B\ren.yml / 0 B"; out.String() != expected {
+		t.Errorf("expected %q, got %q", expected, out.String())
+	}
+}
+
+func TestProgressTransport(t *testing.T) {
+	content := strings.Repeat("a", 4096)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(ioutil.Discard, r.Body)
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		io.WriteString(w, content)
+	}))
+	defer s.Close()
+
+	for _, upload := range []bool{false, true} {
+		out := &bytes.Buffer{}
+		c := &http.Client{Transport: &progressTransport{next: http.DefaultTransport, out: out, label: "en.yml", upload: upload}}
+		resp, err := c.Post(s.URL, "text/plain", strings.NewReader(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(body) != content {
+			t.Errorf("upload %t: expected the response to be passed through", upload)
+		}
+		if !strings.HasPrefix(out.String(), "\ren.yml [") || !strings.Contains(out.String(), "/ 4.0 KB") {
+			t.Errorf("upload %t: expected the progress to be shown, got %q", upload, out.String())
+		}
+	}
+}
@@ -1,10 +1,16 @@
 package main
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/gopkg.in/yaml.v2"
 
@@ -15,36 +21,261 @@ import (
 
 type PullCommand struct {
 	*phraseapp.Config
+
+	Interactive bool     `cli:"opt --interactive desc='select the locales to download if a target matches several (terminal only)'"`
+	Since       string   `cli:"opt --since desc='only download locales updated since the given RFC3339 time, duration (e.g. 24h) or last pull (last)'"`
+	Only        []string `cli:"opt --only desc='only download the given locales (comma separated codes or names)'"`
+	Exclude     []string `cli:"opt --exclude desc='do not download the given locales (comma separated codes or names)'"`
+	OutDir      string   `cli:"opt --out-dir desc='directory relative file patterns of targets are resolved in (also available as out_dir configuration key)'"`
+	Resume      bool     `cli:"opt --resume desc='skip files completely downloaded by a previous, interrupted pull with --resume'"`
+	Locale      string   `cli:"opt --locale desc='only download the locale with the given code, name or ID (overrides params.locale_id)'"`
+	Watch       bool     `cli:"opt --watch desc='pull again every --interval until interrupted with Ctrl-C'"`
+	Interval    string   `cli:"opt --interval default=30s desc='time between pulls with --watch'"`
+	Encoding    string   `cli:"opt --encoding desc='character encoding to write files in, e.g. UTF-16 or ISO-8859-1 (overrides encoding of targets)'"`
+	BOM         bool     `cli:"opt --bom desc='start UTF-8 files with a byte order mark (also available as bom configuration key of targets)'"`
+	LineEndings string   `cli:"opt --line-endings desc='convert line endings of text files to lf or crlf, or keep them as downloaded (overrides line_endings of targets)'"`
+	SortKeys    bool     `cli:"opt --sort-keys desc='sort the keys of JSON and YAML files (also available as sort_keys configuration key of targets)'"`
+
+	VerifiedOnly      bool `cli:"opt --verified-only desc='skip unverified translations (overrides skip_unverified_translations of targets)'"`
+	IncludeUnverified bool `cli:"opt --include-unverified desc='include unverified translations, the default (overrides skip_unverified_translations of targets)'"`
+
+	MaxLocales int `cli:"opt --max-locales desc='fail if a target matches more locales, e.g. because its locale_id is missing (default unlimited)'"`
+
+	NoLock      bool   `cli:"opt --no-lock desc='do not lock the configuration against concurrent pulls'"`
+	LockTimeout string `cli:"opt --lock-timeout default=30s desc='time to wait for another pull of the same configuration to finish'"`
+
+	FailFast  bool `cli:"opt --fail-fast desc='stop at the first file failing to download'"`
+	KeepGoing bool `cli:"opt --keep-going desc='download the other files if one fails and report the failures at the end, the default'"`
 }
 
 func (cmd *PullCommand) Run() error {
+	return cmd.RunContext(commandContext)
+}
+
+// RunContext pulls until ctx is done, which aborts the requests in flight.
+func (cmd *PullCommand) RunContext(ctx context.Context) error {
 	if cmd.Debug {
 		// suppresses content output
 		cmd.Debug = false
 		Debug = true
 	}
-	client, err := newClient(cmd.Config.Credentials)
+	if !cmd.NoLock {
+		timeout, err := parseLockTimeout(cmd.LockTimeout)
+		if err != nil {
+			return &invalidError{err}
+		}
+		lock, err := acquireLock(ctx, lockPath(), timeout)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := lock.Release(); err != nil {
+				logger.Warnf("failed to remove lock: %s", err)
+			}
+		}()
+	}
+
+	pull := func() error {
+		return cmd.pull(ctx)
+	}
+	if !cmd.Watch {
+		return pull()
+	}
+
+	interval, err := parseWatchInterval(cmd.Interval)
+	if err != nil {
+		return &invalidError{err}
+	}
+	return watch(ctx, interval, pull)
+}
+
+func (cmd *PullCommand) pull(ctx context.Context) error {
+	if cmd.VerifiedOnly && cmd.IncludeUnverified {
+		return &invalidError{fmt.Errorf("--verified-only and --include-unverified exclude each other")}
+	}
+	if cmd.MaxLocales < 0 {
+		return &invalidError{fmt.Errorf("--max-locales must not be negative")}
+	}
+
+	client, err := newClientContext(ctx, cmd.Config.Credentials)
 	if err != nil {
 		return err
 	}
 
 	targets, err := TargetsFromConfig(cmd)
 	if err != nil {
-		return err
+		return &invalidError{err}
+	}
+	for _, target := range targets {
+		if cmd.Encoding != "" {
+			target.Encoding = cmd.Encoding
+		}
+		if cmd.BOM {
+			target.BOM = true
+		}
+		if cmd.LineEndings != "" {
+			target.LineEndings = cmd.LineEndings
+		}
+		if cmd.SortKeys {
+			target.SortKeys = true
+		}
+		if err := target.checkEncoding(); err != nil {
+			return &invalidError{err}
+		}
+	}
+
+	formats, err := client.FormatsList(1, 100)
+	if err == nil {
+		if err := targets.checkFormats(formats, Strict); err != nil {
+			return err
+		}
+	}
+
+	started := time.Now()
+	downloads := LoadCache(cachePath())
+	defer func() {
+		if err := downloads.Save(); err != nil {
+			logger.Warnf("failed to write cache: %s", err)
+		}
+	}()
+
+	var since time.Time
+	if cmd.Since != "" {
+		if since, err = parseSince(cmd.Since, started, downloads.LastPull()); err != nil {
+			return &invalidError{err}
+		}
+	}
+
+	stats := &PullStats{}
+	defer func() {
+		if !Quiet {
+			fmt.Println(stats.Summary(time.Since(started)))
+		}
+	}()
+
+	outDir := targetOutDir(cmd.OutDir)
+
+	var manifest *pullManifest
+	if cmd.Resume {
+		manifest = loadManifest(manifestPath())
 	}
 
+	clients := newClientPool(ctx, cmd.Config.Credentials)
+	cache := LocaleCache{}
+	failures, err := newFailures(cmd.FailFast, cmd.KeepGoing)
+	if err != nil {
+		return err
+	}
 	for _, target := range targets {
-		err := target.Pull(client)
+		target.Interactive = cmd.Interactive
+		target.Downloads = downloads
+		target.Since = since
+		target.Stats = stats
+		target.Failures = failures
+		target.MaxLocales = cmd.MaxLocales
+		target.Only = cmd.Only
+		target.Exclude = cmd.Exclude
+		target.OutDir = outDir
+		target.Manifest = manifest
+		if cmd.Locale != "" {
+			if target.Params == nil {
+				target.Params = new(PullParams)
+			}
+			target.Params.LocaleID = cmd.Locale
+		}
+		if cmd.VerifiedOnly || cmd.IncludeUnverified {
+			if target.Params == nil {
+				target.Params = new(PullParams)
+			}
+			target.Params.SkipUnverifiedTranslations = cmd.VerifiedOnly
+		}
+
+		err := target.Pull(clients, cache)
 		if err != nil {
+			if failures.Err() != nil {
+				logger.Errorf("%s", failures)
+			}
+			if stats.Written+stats.Unchanged > 0 {
+				return &partialError{err}
+			}
 			return err
 		}
 	}
+	if err := failures.Err(); err != nil {
+		return err
+	}
 
+	if err := manifest.Remove(); err != nil {
+		logger.Warnf("failed to remove manifest: %s", err)
+	}
+
+	downloads.SetLastPull(started)
+	if !Quiet && cmd.Since != "" {
+		fmt.Printf("Pulled at %s, use --since last to only download newer changes\n", started.UTC().Format(time.RFC3339))
+	}
 	return nil
 }
 
+// parseSince parses the value of the --since option. It's either a RFC3339
+// time, a duration counted back from now or "last" for the last pull.
+func parseSince(value string, now, lastPull time.Time) (time.Time, error) {
+	if value == "last" {
+		if lastPull.IsZero() {
+			return time.Time{}, fmt.Errorf("no previous pull recorded in %s, use a time or duration for --since", cacheName)
+		}
+		return lastPull, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid value %q for --since, expected a RFC3339 time (e.g. 2016-01-02T15:04:05Z), a duration (e.g. 24h) or last", value)
+}
+
+// OutDir is the directory relative file patterns of pull targets are resolved
+// in, set by the out_dir configuration key.
+var OutDir string
+
+// targetOutDir returns the --out-dir flag, relative to the working directory,
+// or else OutDir, which is resolved like the file patterns of the configuration
+// it's set in.
+func targetOutDir(flag string) string {
+	if flag != "" {
+		return flag
+	}
+	return resolvePattern(OutDir)
+}
+
 type Targets []*Target
 
+// checkFormats validates the file extension of every target against the
+// extension of its format. Mismatches are reported as warnings, or as errors
+// if strict is set.
+func (targets Targets) checkFormats(formats []*phraseapp.Format, strict bool) error {
+	formatMap := map[string]*phraseapp.Format{}
+	for _, format := range formats {
+		formatMap[format.ApiName] = format
+	}
+
+	for _, target := range targets {
+		format, found := formatMap[target.GetFormat()]
+		if !found || format.Extension == "" || target.IsStdout() {
+			continue
+		}
+		err := ValidPath(target.File, format.ApiName, format.Extension)
+		switch {
+		case err == nil:
+		case strict:
+			return err
+		default:
+			warnf("%s", err)
+		}
+	}
+	return nil
+}
+
 type Target struct {
 	File          string
 	ProjectID     string
@@ -52,8 +283,38 @@ type Target struct {
 	FileFormat    string
 	Params        *PullParams
 	RemoteLocales []*phraseapp.Locale
+	Interactive   bool
+	Downloads     *Cache
+	Since         time.Time
+	Stats         *PullStats
+	Failures      *MultiError
+	MaxLocales    int
+	Only          []string
+	Exclude       []string
+	OutDir        string
+	Manifest      *pullManifest
+
+	// SourceLocale is written to SourceFile instead of File, e.g. the base
+	// resources of iOS and Android apps, which have no locale in their path.
+	SourceLocale string
+	SourceFile   string
+
+	// Encoding is the character encoding files are written in, UTF-8 if
+	// it's empty.
+	Encoding string
+	// BOM starts UTF-8 files with a byte order mark.
+	BOM bool
+	// LineEndings is lf or crlf to convert the line endings of text files,
+	// they are kept as downloaded if it's empty or keep.
+	LineEndings string
+	// SortKeys sorts the keys of files in formats where their order doesn't
+	// matter.
+	SortKeys bool
 }
 
+// Targets with this file write the downloaded locale to stdout.
+const stdoutFile = "-"
+
 type PullParams struct {
 	phraseapp.LocaleDownloadParams
 	LocaleID string
@@ -62,43 +323,82 @@ type PullParams struct {
 func (tgt *Target) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	m := map[string]interface{}{}
 	err := phraseapp.ParseYAMLToMap(unmarshal, map[string]interface{}{
-		"file":         &tgt.File,
-		"project_id":   &tgt.ProjectID,
-		"access_token": &tgt.AccessToken,
-		"file_format":  &tgt.FileFormat,
-		"params":       &m,
+		"file":          &tgt.File,
+		"project_id":    &tgt.ProjectID,
+		"access_token":  &tgt.AccessToken,
+		"file_format":   &tgt.FileFormat,
+		"source_locale": &tgt.SourceLocale,
+		"source_file":   &tgt.SourceFile,
+		"encoding":      &tgt.Encoding,
+		"bom":           &tgt.BOM,
+		"line_endings":  &tgt.LineEndings,
+		"sort_keys":     &tgt.SortKeys,
+		"params":        &m,
 	})
 	if err != nil {
 		return err
 	}
 
 	tgt.Params = new(PullParams)
-	if v, found := m["locale_id"]; found {
-		if tgt.Params.LocaleID, err = phraseapp.ValidateIsString("params.locale_id", v); err != nil {
+	return tgt.Params.apply(m)
+}
+
+// apply sets the params given in m, mapping the names of params to values.
+func (params *PullParams) apply(m map[string]interface{}) error {
+	values := map[string]interface{}{}
+	for key, value := range m {
+		values[key] = value
+	}
+	if v, found := values["locale_id"]; found {
+		var err error
+		if params.LocaleID, err = phraseapp.ValidateIsString("params.locale_id", v); err != nil {
 			return err
 		}
 		// Must delete the param from the map as the LocaleDownloadParams type
 		// doesn't support this one and the apply method would return an error.
-		delete(m, "locale_id")
+		delete(values, "locale_id")
 	}
-	return tgt.Params.ApplyValuesFromMap(m)
-
+	return params.ApplyValuesFromMap(values)
 }
 
 func (target *Target) CheckPreconditions() error {
-	if err := ValidPath(target.File, target.FileFormat, ""); err != nil {
+	if (target.SourceLocale == "") != (target.SourceFile == "") {
+		return fmt.Errorf("source_locale and source_file of target %s must be set together", target.File)
+	}
+
+	if target.IsStdout() {
+		if target.SourceLocale != "" {
+			return fmt.Errorf("targets writing to stdout can't have a source_locale")
+		}
+		return nil
+	}
+
+	if err := checkPullPattern(target.File, target.FileFormat); err != nil {
+		return err
+	}
+	if target.SourceFile != "" {
+		if err := checkPullPattern(target.SourceFile, target.FileFormat); err != nil {
+			return fmt.Errorf("source_file: %s", err)
+		}
+	}
+	return nil
+}
+
+// checkPullPattern checks the file pattern of a target or its source_file.
+func checkPullPattern(file, fileFormat string) error {
+	if err := ValidPath(file, fileFormat, ""); err != nil {
 		return err
 	}
 
-	if strings.Count(target.File, "*") > 0 {
+	if strings.Count(file, "*") > 0 {
 		return fmt.Errorf(
 			"File pattern for 'pull' cannot include any 'stars' *. Please specify direct and valid paths with file name!\n %s#targets", docsConfigUrl,
 		)
 	}
 
 	duplicatedPlaceholders := []string{}
-	for _, name := range []string{"<locale_name>", "<locale_code>", "<tag>"} {
-		if strings.Count(target.File, name) > 1 {
+	for _, name := range []string{"<locale_name>", "<locale_code>", "<tag>", "<branch>"} {
+		if strings.Count(file, name) > 1 {
 			duplicatedPlaceholders = append(duplicatedPlaceholders, name)
 		}
 	}
@@ -111,44 +411,94 @@ func (target *Target) CheckPreconditions() error {
 	return nil
 }
 
-func (target *Target) Pull(client *phraseapp.Client) error {
+func (target *Target) Pull(clients *clientPool, cache LocaleCache) error {
 	if err := target.CheckPreconditions(); err != nil {
 		return err
 	}
 
-	remoteLocales, err := RemoteLocales(client, target.ProjectID)
+	client, err := clients.Client(target.AccessToken)
+	if err != nil {
+		return fmt.Errorf("%s for target %s", err, target.File)
+	}
+
+	remoteLocales, err := cache.RemoteLocales(client, target.ProjectID)
 	if err != nil {
 		return err
 	}
-	target.RemoteLocales = remoteLocales
+	target.RemoteLocales, err = filterLocales(remoteLocales, target.Only, target.Exclude)
+	if err != nil {
+		return fmt.Errorf("%s in project %s", err, target.ProjectID)
+	}
 
 	localeFiles, err := target.LocaleFiles()
 	if err != nil {
 		return err
 	}
 
-	localeIdToFileIsDistinct := (target.GetLocaleID() != "" && len(localeFiles) == 1)
+	if len(localeFiles) == 0 {
+		return warnf("%s", target.noLocalesError())
+	}
 
-	for _, localeFile := range localeFiles {
-		err := createFile(localeFile.Path)
+	if !target.Since.IsZero() {
+		var stale LocaleFiles
+		localeFiles, stale = target.updatedSince(localeFiles)
+		for _, localeFile := range stale {
+			if !Quiet {
+				fmt.Printf("Skipped %s, not updated since %s\n", localeFile.Message(), target.Since.Format(time.RFC3339))
+			}
+		}
+	}
+
+	if target.Interactive && target.GetLocaleID() == "" && len(localeFiles) > 1 && isTerminal(os.Stdin) {
+		localeFiles, err = selectLocaleFiles(localeFiles, bufio.NewReader(os.Stdin), os.Stderr)
 		if err != nil {
 			return err
 		}
+	}
 
-		if localeIdToFileIsDistinct {
-			if target.GetLocaleID() != "" {
-				localeFile.ID = target.GetLocaleID()
+	if target.MaxLocales > 0 && len(localeFiles) > target.MaxLocales {
+		return &invalidError{fmt.Errorf("target %s matches %d locales, more than the %d allowed by --max-locales; set its locale_id, use --only or raise the limit", target.File, len(localeFiles), target.MaxLocales)}
+	}
+
+	if target.IsStdout() && len(localeFiles) > 1 {
+		return fmt.Errorf("Writing to stdout requires the target to match a single locale, found %d. Please set params.locale_id", len(localeFiles))
+	}
+
+	target.Failures.count(len(localeFiles))
+	for _, localeFile := range localeFiles {
+		if !target.IsStdout() && target.Manifest.Completed(localeFile.Path) {
+			target.Stats.recordNotModified()
+			if !Quiet {
+				fmt.Println("Skipped", localeFile.RelPath(), "(downloaded before the pull was interrupted)")
 			}
+			continue
+		}
+
+		if !target.IsStdout() {
+			localeFile.ExistsLocal = Exists(localeFile.Path) == nil
 		}
 
 		err = target.DownloadAndWriteToFile(client, localeFile)
-		if err != nil {
-			return fmt.Errorf("%s for %s", err, localeFile.Path)
-		} else {
+		switch {
+		case err == errNotModified:
+			sharedMessage("unchanged", localeFile)
+		case err != nil:
+			target.Stats.recordError()
+			if clients.aborted() {
+				return fmt.Errorf("%s for %s", err, localeFile.Path)
+			}
+			if err := target.Failures.Add(localeFile, err); err != nil {
+				return err
+			}
+			continue
+		case !target.IsStdout():
 			sharedMessage("pull", localeFile)
 		}
-		if Debug {
-			fmt.Fprintln(os.Stderr, strings.Repeat("-", 10))
+
+		if !target.IsStdout() {
+			if err := target.Manifest.Record(localeFile.Path); err != nil {
+				logger.Warnf("failed to write manifest: %s", err)
+			}
 		}
 	}
 
@@ -165,39 +515,124 @@ func (target *Target) DownloadAndWriteToFile(client *phraseapp.Client, localeFil
 		downloadParams.FileFormat = &localeFile.FileFormat
 	}
 
-	if Debug {
-		fmt.Fprintln(os.Stderr, "Target file pattern:", target.File)
-		fmt.Fprintln(os.Stderr, "Actual file path", localeFile.Path)
-		fmt.Fprintln(os.Stderr, "LocaleID", localeFile.ID)
-		fmt.Fprintln(os.Stderr, "ProjectID", target.ProjectID)
-		fmt.Fprintln(os.Stderr, "FileFormat", downloadParams.FileFormat)
-		fmt.Fprintln(os.Stderr, "ConvertEmoji", downloadParams.ConvertEmoji)
-		fmt.Fprintln(os.Stderr, "IncludeEmptyTranslations", downloadParams.IncludeEmptyTranslations)
-		fmt.Fprintln(os.Stderr, "KeepNotranslateTags", downloadParams.KeepNotranslateTags)
-		fmt.Fprintln(os.Stderr, "Tag", downloadParams.Tag)
-		fmt.Fprintln(os.Stderr, "FormatOptions", downloadParams.FormatOptions)
+	logger.Debugf("Target file pattern: %s", target.File)
+	logger.Debugf("Actual file path: %s", localeFile.Path)
+	logger.Debugf("LocaleID: %s, ProjectID: %s", localeFile.ID, target.ProjectID)
+	if logger.enabled(levelDebug) {
+		params, _ := json.Marshal(downloadParams)
+		logger.Debugf("Download params: %s", params)
 	}
 
-	res, err := client.LocaleDownload(target.ProjectID, localeFile.ID, downloadParams)
-	if err != nil {
+	if localeFile.Path == stdoutFile {
+		res, err := client.LocaleDownload(target.ProjectID, localeFile.ID, downloadParams)
+		if err != nil {
+			return err
+		}
+		if res, err = target.encode(res, *downloadParams.FileFormat); err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(res)
+		return err
+	}
+
+	key := downloadCacheKey(target.ProjectID, localeFile.ID, *downloadParams.FileFormat, localeFile.Path)
+	conditional, transport := conditionalClient(client, target.Downloads.ETag(key, localeFile.Path))
+	if showProgress() {
+		conditional = progressClient(conditional, localeFile.RelPath(), false)
+	}
+	res, err := conditional.LocaleDownload(target.ProjectID, localeFile.ID, downloadParams)
+	if isNotModified(err) {
+		target.Stats.recordNotModified()
+		return errNotModified
+	} else if err != nil {
+		return err
+	}
+	if res, err = target.encode(res, *downloadParams.FileFormat); err != nil {
 		return err
 	}
 
-	err = ioutil.WriteFile(localeFile.Path, res, 0700)
+	// Nothing is created before the download succeeded, the file is moved
+	// into place once it's written completely.
+	if err := os.MkdirAll(filepath.Dir(localeFile.Path), localeDirMode); err != nil {
+		return err
+	}
+	previous, _ := ioutil.ReadFile(localeFile.Path)
+	err = updateFileAtomic(localeFile.Path, res, localeFileMode)
 	if err != nil {
 		return err
 	}
+	target.Stats.recordWrite(localeFile.ExistsLocal, previous, res)
+	target.Downloads.Store(key, transport.responseETag, res)
 	return nil
 }
 
+// checkEncoding checks the encoding, byte order mark and line endings
+// settings.
+func (target *Target) checkEncoding() error {
+	if _, err := parseLineEndings(target.LineEndings); err != nil {
+		return fmt.Errorf("%s (target %s)", err, target.File)
+	}
+	enc, err := lookupEncoder(target.Encoding)
+	if err != nil {
+		return fmt.Errorf("%s (target %s)", err, target.File)
+	}
+	// params.encoding makes PhraseApp encode the file, which encoding would
+	// then transcode a second time.
+	if target.Encoding != "" && target.Params != nil && target.Params.Encoding != nil && *target.Params.Encoding != "" {
+		return fmt.Errorf("encoding and params.encoding can't both be set, the file would be encoded twice (target %s)", target.File)
+	}
+	if target.BOM && enc != nil {
+		return fmt.Errorf("a UTF-8 byte order mark can't be added to files encoded in %s (target %s)", target.Encoding, target.File)
+	}
+	return nil
+}
+
+// encode sorts the keys of the downloaded content in format, converts its
+// line endings, transcodes it to the encoding of the target and adds the byte
+// order mark, as far as requested.
+func (target *Target) encode(content []byte, format string) ([]byte, error) {
+	lineEndings, err := parseLineEndings(target.LineEndings)
+	if err != nil {
+		return nil, err
+	}
+	if target.SortKeys {
+		content = sortKeys(content, format)
+	}
+	content = normalizeLineEndings(content, lineEndings, format)
+
+	enc, err := lookupEncoder(target.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	if enc == nil {
+		if target.BOM {
+			content = addBOM(content)
+		}
+		return content, nil
+	}
+	return enc(content)
+}
+
 func (target *Target) LocaleFiles() (LocaleFiles, error) {
-	localeID := target.GetLocaleID()
+	localeID, err := target.resolveLocaleID()
+	if err != nil {
+		return nil, err
+	}
+	sourceLocaleID, err := target.resolveSourceLocaleID()
+	if err != nil {
+		return nil, err
+	}
+
 	files := []*LocaleFile{}
 	for _, remoteLocale := range target.RemoteLocales {
-		if localeID != "" && !(remoteLocale.ID == localeID || remoteLocale.Name == localeID) {
+		if target.GetLocaleID() != "" && remoteLocale.ID != localeID {
 			continue
 		}
-		err := target.IsValidLocale(remoteLocale, target.File)
+		pattern := target.File
+		if sourceLocaleID != "" && remoteLocale.ID == sourceLocaleID {
+			pattern = target.SourceFile
+		}
+		err := target.IsValidLocale(remoteLocale, pattern)
 		if err != nil {
 			return nil, err
 		}
@@ -208,10 +643,10 @@ func (target *Target) LocaleFiles() (LocaleFiles, error) {
 			Code:       remoteLocale.Code,
 			Tag:        target.GetTag(),
 			FileFormat: target.GetFormat(),
-			Path:       target.File,
+			Path:       pattern,
 		}
 
-		absPath, err := target.ReplacePlaceholders(localeFile)
+		absPath, err := target.replacePlaceholdersIn(pattern, localeFile)
 		if err != nil {
 			return nil, err
 		}
@@ -223,6 +658,53 @@ func (target *Target) LocaleFiles() (LocaleFiles, error) {
 	return files, nil
 }
 
+// resolveLocaleID returns the ID of the remote locale params.locale_id refers
+// to by ID, name or code (case-insensitive). It's empty if no locale_id is set
+// or no remote locale matches.
+func (target *Target) resolveLocaleID() (string, error) {
+	return target.matchLocale("locale", target.GetLocaleID())
+}
+
+// resolveSourceLocaleID returns the ID of the remote locale source_locale
+// refers to by ID, name or code. It's an error if no locale matches, unless
+// the locales were filtered with --only or --exclude.
+func (target *Target) resolveSourceLocaleID() (string, error) {
+	id, err := target.matchLocale("source_locale", target.SourceLocale)
+	if err != nil || id != "" || target.SourceLocale == "" {
+		return id, err
+	}
+	if len(target.Only) > 0 || len(target.Exclude) > 0 {
+		return "", nil
+	}
+	return "", fmt.Errorf("source_locale %q of target %s matches none of the locales of project %s", target.SourceLocale, target.File, target.ProjectID)
+}
+
+// matchLocale returns the ID of the remote locale matching value by ID, name
+// or code (case-insensitive), setting names the option value was set with.
+func (target *Target) matchLocale(setting, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	matches := []string{}
+	for _, locale := range target.RemoteLocales {
+		if locale.ID == value {
+			return locale.ID, nil
+		}
+		if locale.Name == value || strings.EqualFold(locale.Code, value) {
+			matches = append(matches, locale.ID)
+		}
+	}
+
+	if len(matches) > 1 {
+		return "", fmt.Errorf("%s %q of target %s is ambiguous, it matches the locales %s. Please use the locale ID", setting, value, target.File, strings.Join(matches, ", "))
+	}
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+	return "", nil
+}
+
 func (target *Target) IsValidLocale(locale *phraseapp.Locale, localPath string) error {
 	if locale == nil {
 		return fmt.Errorf("Remote locale could not be downloaded correctly!")
@@ -235,7 +717,23 @@ func (target *Target) IsValidLocale(locale *phraseapp.Locale, localPath string)
 }
 
 func (target *Target) ReplacePlaceholders(localeFile *LocaleFile) (string, error) {
-	absPath, err := filepath.Abs(target.File)
+	return target.replacePlaceholdersIn(target.File, localeFile)
+}
+
+func (target *Target) replacePlaceholdersIn(file string, localeFile *LocaleFile) (string, error) {
+	if target.IsStdout() {
+		return stdoutFile, nil
+	}
+
+	switch {
+	case filepath.IsAbs(file):
+	case target.OutDir != "":
+		file = filepath.Join(target.OutDir, file)
+	default:
+		file = resolvePattern(file)
+	}
+
+	absPath, err := filepath.Abs(file)
 	if err != nil {
 		return "", err
 	}
@@ -243,10 +741,15 @@ func (target *Target) ReplacePlaceholders(localeFile *LocaleFile) (string, error
 	path := strings.Replace(absPath, "<locale_name>", localeFile.Name, -1)
 	path = strings.Replace(path, "<locale_code>", localeFile.Code, -1)
 	path = strings.Replace(path, "<tag>", localeFile.Tag, -1)
+	path = strings.Replace(path, "<branch>", Branch, -1)
 
 	return path, nil
 }
 
+func (t *Target) IsStdout() bool {
+	return t.File == stdoutFile
+}
+
 func (t *Target) GetFormat() string {
 	if t.Params != nil && t.Params.FileFormat != nil {
 		return *t.Params.FileFormat
@@ -273,7 +776,7 @@ func (t *Target) GetTag() string {
 
 func TargetsFromConfig(cmd *PullCommand) (Targets, error) {
 	if cmd.Config.Targets == nil || len(cmd.Config.Targets) == 0 {
-		return nil, fmt.Errorf("no targets for download specified")
+		return nil, fmt.Errorf("no targets for download specified%s", configHint())
 	}
 
 	tmp := struct {
@@ -288,21 +791,45 @@ func TargetsFromConfig(cmd *PullCommand) (Targets, error) {
 	token := cmd.Credentials.Token
 	projectId := cmd.Config.DefaultProjectID
 	fileFormat := cmd.Config.DefaultFileFormat
+	formatOptions, err := defaultFormatOptions(cmd.Config)
+	if err != nil {
+		return nil, err
+	}
 
 	validTargets := []*Target{}
-	for _, target := range tgts {
+	for i, target := range tgts {
 		if target == nil {
 			continue
 		}
-		if target.ProjectID == "" {
+		if target.ProjectID == "" || ProjectIDOverride != "" {
 			target.ProjectID = projectId
 		}
 		if target.AccessToken == "" {
 			target.AccessToken = token
 		}
+		if err := target.checkCredentials(i, cmd.Credentials.Username != ""); err != nil {
+			return nil, err
+		}
 		if target.FileFormat == "" {
 			target.FileFormat = fileFormat
 		}
+		warnMissingBranch(target.File)
+		if overrides != nil && overrides.PullParams != nil {
+			if target.Params == nil {
+				target.Params = new(PullParams)
+			}
+			if err := target.Params.apply(overrides.PullParams); err != nil {
+				return nil, fmt.Errorf("invalid pull.params given with --stdin-yaml: %s", err)
+			}
+		}
+		if target.Params != nil {
+			target.Params.FormatOptions = mergeFormatOptions(formatOptions, target.Params.FormatOptions)
+			for _, key := range unknownFormatOptions(target.GetFormat(), target.Params.FormatOptions) {
+				if err := warnf("format option %q is not supported by format %q (target %s)", key, target.GetFormat(), target.File); err != nil {
+					return nil, err
+				}
+			}
+		}
 		validTargets = append(validTargets, target)
 	}
 
@@ -313,20 +840,150 @@ func TargetsFromConfig(cmd *PullCommand) (Targets, error) {
 	return validTargets, nil
 }
 
-func createFile(path string) error {
-	err := Exists(path)
+// checkCredentials fails for a target without project or access token after
+// falling back to the ones of the phraseapp section, which would otherwise
+// fail with an unhelpful API error. i is the index of the target in the
+// configuration. Without token, username authentication must be used.
+func (target *Target) checkCredentials(i int, hasUsername bool) error {
+	switch {
+	case target.ProjectID == "":
+		return fmt.Errorf("pull target %d (%s) has no project_id, set it on the target or in the phraseapp section of your configuration, or pass --project-id", i+1, target.File)
+	case target.AccessToken == "" && !hasUsername:
+		return fmt.Errorf("pull target %d (%s) has no access_token, set it on the target or in the phraseapp section of your configuration, or pass --access-token", i+1, target.File)
+	}
+	return nil
+}
+
+// localeDirMode is the mode of directories created for locale files, minus
+// the umask.
+const localeDirMode os.FileMode = 0755
+
+// localeFileMode is the mode new locale files are created with, minus the
+// umask. Existing files keep their mode.
+const localeFileMode os.FileMode = 0644
+
+// noLocalesError explains why the target matches no locales.
+func (target *Target) noLocalesError() error {
+	if len(target.RemoteLocales) == 0 {
+		return fmt.Errorf("nothing downloaded for %s, project %s has no locales", target.File, target.ProjectID)
+	}
+	return fmt.Errorf("nothing downloaded for %s, none of the %d locales of project %s matches locale_id %q by ID, name or code", target.File, len(target.RemoteLocales), target.ProjectID, target.GetLocaleID())
+}
+
+// filterLocales returns the locales matching one of only (all if it's empty)
+// and none of exclude. Both are compared case-insensitively with the code and
+// name of a locale. Entries of only matching no locale are an error.
+func filterLocales(locales []*phraseapp.Locale, only, exclude []string) ([]*phraseapp.Locale, error) {
+	if len(only) == 0 && len(exclude) == 0 {
+		return locales, nil
+	}
+
+	matches := func(locale *phraseapp.Locale, value string) bool {
+		return strings.EqualFold(locale.Code, value) || strings.EqualFold(locale.Name, value)
+	}
+
+	missing := []string{}
+	for _, value := range only {
+		found := false
+		for _, locale := range locales {
+			if matches(locale, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, value)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("no locale found for --only %s", strings.Join(missing, ", "))
+	}
+
+	filtered := []*phraseapp.Locale{}
+	for _, locale := range locales {
+		included := len(only) == 0
+		for _, value := range only {
+			included = included || matches(locale, value)
+		}
+		for _, value := range exclude {
+			included = included && !matches(locale, value)
+		}
+		if included {
+			filtered = append(filtered, locale)
+		}
+	}
+	return filtered, nil
+}
+
+// updatedSince splits the locale files into the ones whose remote locale was
+// updated since target.Since and the ones that weren't. Locales without an
+// update time are always considered updated.
+func (target *Target) updatedSince(localeFiles LocaleFiles) (updated, stale LocaleFiles) {
+	remote := map[string]*phraseapp.Locale{}
+	for _, locale := range target.RemoteLocales {
+		remote[locale.ID] = locale
+	}
+
+	for _, localeFile := range localeFiles {
+		locale := remote[localeFile.ID]
+		if locale != nil && locale.UpdatedAt != nil && locale.UpdatedAt.Before(target.Since) {
+			stale = append(stale, localeFile)
+		} else {
+			updated = append(updated, localeFile)
+		}
+	}
+	return updated, stale
+}
+
+// isTerminal reports whether f is connected to a terminal.
+func isTerminal(f *os.File) bool {
+	stat, err := f.Stat()
 	if err != nil {
-		absDir := filepath.Dir(path)
-		err := Exists(absDir)
-		if err != nil {
-			os.MkdirAll(absDir, 0700)
+		return false
+	}
+	return stat.Mode()&os.ModeCharDevice != 0
+}
+
+// selectLocaleFiles lists the locale files and lets the user pick the ones to
+// download by their position in the list. An empty answer selects all of them.
+// The prompt is written to out, so downloads to stdout are left untouched.
+func selectLocaleFiles(localeFiles LocaleFiles, in *bufio.Reader, out io.Writer) (LocaleFiles, error) {
+	for counter, localeFile := range localeFiles {
+		fmt.Fprintf(out, "%2d. %s (Code: %s)\n", counter+1, localeFile.Name, localeFile.Code)
+	}
+
+	for {
+		fmt.Fprint(out, "Select locales, e.g. 1,3 [default: all]: ")
+		line, err := in.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return nil, fmt.Errorf("no locales selected")
 		}
 
-		f, err := os.Create(path)
-		if err != nil {
-			return err
+		selected, err := parseSelection(line, localeFiles)
+		if err == nil {
+			return selected, nil
 		}
-		defer f.Close()
+		fmt.Fprintln(out, err)
 	}
-	return nil
+}
+
+func parseSelection(answer string, localeFiles LocaleFiles) (LocaleFiles, error) {
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return localeFiles, nil
+	}
+
+	selected := LocaleFiles{}
+	seen := map[int]bool{}
+	for _, part := range strings.Split(answer, ",") {
+		number, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || number < 1 || number > len(localeFiles) {
+			return nil, fmt.Errorf("Argument Error: Please select locales by their position in the list, e.g. 2 for the second locale.")
+		}
+		if !seen[number] {
+			seen[number] = true
+			selected = append(selected, localeFiles[number-1])
+		}
+	}
+	return selected, nil
 }
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+)
+
+// PullStats sums up the locale files handled by a pull across all targets.
+type PullStats struct {
+	Written   int
+	Bytes     int64
+	Created   int
+	Updated   int
+	Unchanged int
+	Errors    int
+}
+
+// recordWrite records a locale file written with content, previous is the
+// content of the file before.
+func (stats *PullStats) recordWrite(existed bool, previous, content []byte) {
+	if stats == nil {
+		return
+	}
+	stats.Written++
+	stats.Bytes += int64(len(content))
+	switch {
+	case !existed:
+		stats.Created++
+	case bytes.Equal(previous, content):
+		stats.Unchanged++
+	default:
+		stats.Updated++
+	}
+}
+
+func (stats *PullStats) recordNotModified() {
+	if stats != nil {
+		stats.Unchanged++
+	}
+}
+
+func (stats *PullStats) recordError() {
+	if stats != nil {
+		stats.Errors++
+	}
+}
+
+func (stats *PullStats) Summary(elapsed time.Duration) string {
+	return fmt.Sprintf(
+		"%d files written (%s): %d created, %d updated, %d unchanged, %d errors in %.1fs",
+		stats.Written, formatBytes(stats.Bytes), stats.Created, stats.Updated, stats.Unchanged, stats.Errors, elapsed.Seconds(),
+	)
+}
+
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
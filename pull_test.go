@@ -1,9 +1,20 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
 )
 
 func getBaseTarget() *Target {
@@ -28,6 +39,7 @@ func TestPullPreconditions(t *testing.T) {
 		"./**/*/*/en.yml",
 		"./**/*/en.yml",
 		"./**/*/<locale_name>/<locale_code>/<tag>.yml",
+		"./<branch>/<branch>/<locale_code>.yml",
 	} {
 		target.File = file
 		if err := target.CheckPreconditions(); err == nil {
@@ -122,3 +134,608 @@ func TestReplacePlaceholders(t *testing.T) {
 		t.Errorf("Expected the new path to eql '%s' and not %s", "/en/abc/english.yml", newPath)
 	}
 }
+
+func TestReplaceBranchPlaceholder(t *testing.T) {
+	defer func() { Branch = "" }()
+
+	target := getBaseTarget()
+	target.File = "./<branch>/<locale_code>.yml"
+	localeFile := &LocaleFile{Code: "en"}
+
+	Branch = "feature"
+	newPath, err := target.ReplacePlaceholders(localeFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(newPath, "/feature/en.yml") {
+		t.Errorf("Expected the new path to end with '%s' and not %s", "/feature/en.yml", newPath)
+	}
+
+	Branch = ""
+	newPath, err = target.ReplacePlaceholders(localeFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(newPath, "<branch>") || !strings.HasSuffix(newPath, "/en.yml") {
+		t.Errorf("Expected the placeholder to be left empty, got %s", newPath)
+	}
+}
+
+func TestUnknownFormatOptions(t *testing.T) {
+	unknown := unknownFormatOptions("csv", map[string]string{
+		"column_separator": ";",
+		"colum_separator":  ";",
+	})
+	if len(unknown) != 1 || unknown[0] != "colum_separator" {
+		t.Errorf("expected misspelled option to be reported, got %v", unknown)
+	}
+
+	unknown = unknownFormatOptions("some_format", map[string]string{"anything": "true"})
+	if len(unknown) != 0 {
+		t.Errorf("expected options of unvalidated formats to be accepted, got %v", unknown)
+	}
+}
+
+func TestStdoutTarget(t *testing.T) {
+	target := getBaseTarget()
+	target.File = "-"
+	target.Params.LocaleID = "en-locale-id"
+
+	if err := target.CheckPreconditions(); err != nil {
+		t.Errorf("CheckPrecondition should not fail for stdout target: %s", err)
+	}
+
+	localeFiles, err := target.LocaleFiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(localeFiles) != 1 || localeFiles[0].Path != "-" {
+		t.Errorf("expected a single locale file written to stdout, got %v", localeFiles)
+	}
+}
+
+func TestLocaleCache(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		io.WriteString(w, `[{"id":"en-locale-id","code":"en","name":"english"}]`)
+	}))
+	defer srv.Close()
+
+	client := newTestClient(srv.URL)
+	cache := LocaleCache{}
+	for i := 0; i < 3; i++ {
+		locales, err := cache.RemoteLocales(client, "project-id")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(locales) != 1 || locales[0].Code != "en" {
+			t.Errorf("expected the english locale, got %v", locales)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected locales to be requested once, got %d requests", requests)
+	}
+}
+
+func TestSelectLocaleFiles(t *testing.T) {
+	localeFiles := LocaleFiles{
+		{Name: "english", Code: "en"},
+		{Name: "german", Code: "de"},
+		{Name: "french", Code: "fr"},
+	}
+
+	out := &bytes.Buffer{}
+	in := bufio.NewReader(strings.NewReader("4\n3,1\n"))
+	selected, err := selectLocaleFiles(localeFiles, in, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 2 || selected[0].Code != "fr" || selected[1].Code != "en" {
+		t.Errorf("expected fr and en to be selected, got %v", selected)
+	}
+	if !strings.Contains(out.String(), " 2. german (Code: de)") {
+		t.Errorf("expected locales to be listed, got:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "Argument Error") {
+		t.Errorf("expected invalid selection to be reported, got:\n%s", out.String())
+	}
+
+	selected, err = selectLocaleFiles(localeFiles, bufio.NewReader(strings.NewReader("\n")), out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != len(localeFiles) {
+		t.Errorf("expected an empty answer to select all locales, got %d", len(selected))
+	}
+}
+
+func TestCheckFormats(t *testing.T) {
+	formats := []*phraseapp.Format{{ApiName: "yml", Extension: "yml"}}
+
+	target := getBaseTarget()
+	target.File = "./locales/<locale_code>.json"
+	if err := (Targets{target}).checkFormats(formats, false); err != nil {
+		t.Errorf("expected a mismatch to be a warning only, got %s", err)
+	}
+	if err := (Targets{target}).checkFormats(formats, true); err == nil {
+		t.Errorf("expected a mismatch to be an error in strict mode")
+	}
+
+	target.File = "./locales/<locale_code>.yml"
+	if err := (Targets{target}).checkFormats(formats, true); err != nil {
+		t.Errorf("expected matching extension to pass, got %s", err)
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2016, 5, 10, 12, 0, 0, 0, time.UTC)
+	lastPull := time.Date(2016, 5, 9, 8, 0, 0, 0, time.UTC)
+
+	for value, expected := range map[string]time.Time{
+		"2016-05-01T00:00:00Z": time.Date(2016, 5, 1, 0, 0, 0, 0, time.UTC),
+		"24h":                  now.Add(-24 * time.Hour),
+		"last":                 lastPull,
+	} {
+		since, err := parseSince(value, now, lastPull)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", value, err)
+		} else if !since.Equal(expected) {
+			t.Errorf("expected %q to be parsed as %s, got %s", value, expected, since)
+		}
+	}
+
+	if _, err := parseSince("yesterday", now, lastPull); err == nil {
+		t.Errorf("expected an error for an invalid value")
+	}
+	if _, err := parseSince("last", now, time.Time{}); err == nil {
+		t.Errorf("expected an error for last without a previous pull")
+	}
+}
+
+func TestTargetUpdatedSince(t *testing.T) {
+	old := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	recent := time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	target := getBaseTarget()
+	target.Since = time.Date(2016, 3, 1, 0, 0, 0, 0, time.UTC)
+	target.RemoteLocales = []*phraseapp.Locale{
+		{ID: "en-id", UpdatedAt: &old},
+		{ID: "de-id", UpdatedAt: &recent},
+		{ID: "fr-id"},
+	}
+	localeFiles := LocaleFiles{{ID: "en-id"}, {ID: "de-id"}, {ID: "fr-id"}}
+
+	updated, stale := target.updatedSince(localeFiles)
+	if len(updated) != 2 || updated[0].ID != "de-id" || updated[1].ID != "fr-id" {
+		t.Errorf("expected de-id and fr-id to be updated, got %v", updated)
+	}
+	if len(stale) != 1 || stale[0].ID != "en-id" {
+		t.Errorf("expected en-id to be stale, got %v", stale)
+	}
+}
+
+func TestPullStats(t *testing.T) {
+	stats := &PullStats{}
+	stats.recordWrite(false, nil, []byte("new"))
+	stats.recordWrite(true, []byte("old"), []byte("changed"))
+	stats.recordWrite(true, []byte("same"), []byte("same"))
+	stats.recordNotModified()
+	stats.recordError()
+
+	expected := "3 files written (14 B): 1 created, 1 updated, 2 unchanged, 1 errors in 1.5s"
+	if summary := stats.Summary(1500 * time.Millisecond); summary != expected {
+		t.Errorf("expected summary %q, got %q", expected, summary)
+	}
+
+	if size := formatBytes(1536); size != "1.5 KB" {
+		t.Errorf("expected 1.5 KB, got %s", size)
+	}
+}
+
+func TestNoLocalesError(t *testing.T) {
+	target := getBaseTarget()
+	target.RemoteLocales = nil
+	if err := target.noLocalesError(); !strings.Contains(err.Error(), "has no locales") {
+		t.Errorf("expected an empty project to be reported, got %s", err)
+	}
+
+	target.RemoteLocales = getBaseLocales()
+	target.Params.LocaleID = "unknown"
+	if err := target.noLocalesError(); !strings.Contains(err.Error(), `matches locale_id "unknown"`) {
+		t.Errorf("expected the locale_id filter to be reported, got %s", err)
+	}
+}
+
+func TestPullNestedLocaleDirectory(t *testing.T) {
+	defer func(quiet bool) { Quiet = quiet }(Quiet)
+	Quiet = true
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/download") {
+			io.WriteString(w, "{}")
+			return
+		}
+		io.WriteString(w, `[{"id":"en-locale-id","code":"en","name":"english"},{"id":"de-locale-id","code":"de","name":"german"}]`)
+	}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "phraseapp-pull")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	target := getBaseTarget()
+	target.File = filepath.Join(dir, "i18n/<locale_code>/messages.json")
+	clients := newClientPool(context.Background(), &phraseapp.Credentials{Host: srv.URL})
+	if err := target.Pull(clients, LocaleCache{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, code := range []string{"en", "de"} {
+		path := filepath.Join(dir, "i18n", code, "messages.json")
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("expected %s to be created: %s", path, err)
+		}
+
+		info, err := os.Stat(filepath.Dir(path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mode := info.Mode().Perm(); mode&^localeDirMode != 0 {
+			t.Errorf("expected directory mode within %v, got %v", localeDirMode, mode)
+		}
+	}
+}
+
+func TestPullFailedDownloadCreatesNothing(t *testing.T) {
+	defer func(quiet bool) { Quiet = quiet }(Quiet)
+	Quiet = true
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/download") {
+			w.WriteHeader(http.StatusInternalServerError)
+			io.WriteString(w, `{"message":"boom"}`)
+			return
+		}
+		io.WriteString(w, `[{"id":"en-locale-id","code":"en","name":"english"}]`)
+	}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "phraseapp-pull")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	target := getBaseTarget()
+	target.File = filepath.Join(dir, "i18n/<locale_code>/messages.json")
+	clients := newClientPool(context.Background(), &phraseapp.Credentials{Host: srv.URL})
+	if err := target.Pull(clients, LocaleCache{}); err == nil {
+		t.Fatal("expected the failed download to be an error")
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected no file or directory to be left after the failed download, got %s", files[0].Name())
+	}
+}
+
+func TestFilterLocales(t *testing.T) {
+	codes := func(locales []*phraseapp.Locale) string {
+		list := []string{}
+		for _, locale := range locales {
+			list = append(list, locale.Code)
+		}
+		return strings.Join(list, ",")
+	}
+
+	for _, tc := range []struct {
+		only, exclude []string
+		expected      string
+	}{
+		{nil, nil, "en,de"},
+		{[]string{"DE"}, nil, "de"},
+		{[]string{"English", "de"}, nil, "en,de"},
+		{nil, []string{"german"}, "en"},
+		{[]string{"en", "de"}, []string{"EN"}, "de"},
+	} {
+		locales, err := filterLocales(getBaseLocales(), tc.only, tc.exclude)
+		if err != nil {
+			t.Errorf("only %v, exclude %v: %s", tc.only, tc.exclude, err)
+			continue
+		}
+		if got := codes(locales); got != tc.expected {
+			t.Errorf("only %v, exclude %v: expected %s, got %s", tc.only, tc.exclude, tc.expected, got)
+		}
+	}
+
+	if _, err := filterLocales(getBaseLocales(), []string{"en", "fr"}, nil); err == nil || !strings.Contains(err.Error(), "fr") {
+		t.Errorf("expected an error naming the missing locale fr, got %v", err)
+	}
+}
+
+func TestLocaleFilesByLocaleCode(t *testing.T) {
+	target := getBaseTarget()
+	for _, value := range []string{"de-locale-id", "german", "de", "DE"} {
+		target.Params.LocaleID = value
+		localeFiles, err := target.LocaleFiles()
+		if err != nil {
+			t.Errorf("locale_id %q: %s", value, err)
+			continue
+		}
+		if len(localeFiles) != 1 || localeFiles[0].ID != "de-locale-id" {
+			t.Errorf("locale_id %q: expected the de locale, got %v", value, localeFiles)
+		}
+	}
+
+	target.Params.LocaleID = "fr"
+	if localeFiles, err := target.LocaleFiles(); err != nil || len(localeFiles) != 0 {
+		t.Errorf("expected no locale files for an unknown code, got %v (%v)", localeFiles, err)
+	}
+
+	target.RemoteLocales = append(getBaseLocales(), &phraseapp.Locale{ID: "de-at-locale-id", Code: "DE", Name: "austrian"})
+	target.Params.LocaleID = "de"
+	if _, err := target.LocaleFiles(); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected an error for an ambiguous code, got %v", err)
+	}
+}
+
+func TestReplacePlaceholdersOutDir(t *testing.T) {
+	target := getBaseTarget()
+	target.OutDir = "src/locales"
+	localeFile := &LocaleFile{Code: "en"}
+
+	target.File = "./config/<locale_code>.yml"
+	newPath, err := target.ReplacePlaceholders(localeFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := filepath.Join(workingDir(), "src/locales/config/en.yml"); newPath != expected {
+		t.Errorf("expected the path %s, got %s", expected, newPath)
+	}
+
+	target.File = "/tmp/<locale_code>.yml"
+	newPath, err = target.ReplacePlaceholders(localeFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "/tmp/en.yml"; newPath != expected {
+		t.Errorf("expected absolute patterns to ignore the out dir, got %s", newPath)
+	}
+}
+
+func TestLocaleFilesSourceLocale(t *testing.T) {
+	target := getBaseTarget()
+	target.File = "./res/values-<locale_code>/strings.xml"
+	target.SourceLocale = "english"
+	target.SourceFile = "./res/values/strings.xml"
+	if err := target.CheckPreconditions(); err != nil {
+		t.Fatal(err)
+	}
+
+	localeFiles, err := target.LocaleFiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	enPath, _ := filepath.Abs("./res/values/strings.xml")
+	dePath, _ := filepath.Abs("./res/values-de/strings.xml")
+	if len(localeFiles) != 2 || localeFiles[0].Path != enPath || localeFiles[1].Path != dePath {
+		t.Errorf("expected the source locale at %s and de at %s, got %v", enPath, dePath, localeFiles)
+	}
+
+	target.SourceLocale = "fr"
+	if _, err := target.LocaleFiles(); err == nil {
+		t.Errorf("expected an error for a source_locale matching no locale")
+	}
+	target.Only = []string{"de"}
+	if _, err := target.LocaleFiles(); err != nil {
+		t.Errorf("expected no error for a source_locale filtered out, got %s", err)
+	}
+
+	target.SourceFile = ""
+	if err := target.CheckPreconditions(); err == nil {
+		t.Errorf("expected an error for a source_locale without source_file")
+	}
+}
+
+func TestPullVerifiedOnlyConflict(t *testing.T) {
+	cmd := &PullCommand{VerifiedOnly: true, IncludeUnverified: true}
+	err := cmd.pull(context.Background())
+	if _, ok := err.(*invalidError); !ok {
+		t.Errorf("expected --verified-only with --include-unverified to be invalid, got %v", err)
+	}
+}
+
+func TestPullMaxLocales(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `[{"id":"en-locale-id","code":"en","name":"english"},{"id":"de-locale-id","code":"de","name":"german"}]`)
+	}))
+	defer srv.Close()
+
+	target := getBaseTarget()
+	target.MaxLocales = 1
+	clients := newClientPool(context.Background(), &phraseapp.Credentials{Host: srv.URL})
+	err := target.Pull(clients, LocaleCache{})
+	if _, ok := err.(*invalidError); !ok || !strings.Contains(err.Error(), "matches 2 locales") {
+		t.Errorf("expected an error naming the 2 matched locales, got %v", err)
+	}
+}
+
+func TestReplacePlaceholdersRelativeToConfig(t *testing.T) {
+	defer os.Setenv("PHRASEAPP_CONFIG", os.Getenv("PHRASEAPP_CONFIG"))
+	defer func() { BaseDir = "" }()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	root, err := ioutil.TempDir("", "phraseapp-pull")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	if root, err = filepath.EvalSymlinks(root); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("PHRASEAPP_CONFIG", "")
+	for _, dir := range []string{".git", "app/src"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, configName), []byte("phraseapp: {}\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	target := getBaseTarget()
+	target.File = "locales/<locale_code>.yml"
+	localeFile := &LocaleFile{Code: "en"}
+	expected := filepath.Join(root, "locales", "en.yml")
+	for _, dir := range []string{root, filepath.Join(root, "app"), filepath.Join(root, "app", "src")} {
+		if err := os.Chdir(dir); err != nil {
+			t.Fatal(err)
+		}
+		if path, err := target.ReplacePlaceholders(localeFile); err != nil || path != expected {
+			t.Errorf("%s: expected %s, got %s (%v)", dir, expected, path, err)
+		}
+	}
+
+	BaseDir = filepath.Join(root, "app")
+	expected = filepath.Join(root, "app", "locales", "en.yml")
+	if path, err := target.ReplacePlaceholders(localeFile); err != nil || path != expected {
+		t.Errorf("expected --base-dir to be used, got %s (%v)", path, err)
+	}
+}
+
+func TestPullKeepsExistingFiles(t *testing.T) {
+	defer func(quiet bool) { Quiet = quiet }(Quiet)
+	Quiet = true
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/download") {
+			io.WriteString(w, "new: content\n")
+			return
+		}
+		io.WriteString(w, `[{"id":"en-locale-id","code":"en","name":"english"},{"id":"de-locale-id","code":"de","name":"german"},{"id":"fr-locale-id","code":"fr","name":"french"}]`)
+	}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "phraseapp-pull")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	en := filepath.Join(dir, "en.yml")
+	if err := ioutil.WriteFile(en, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	shared := filepath.Join(dir, "shared", "de.yml")
+	if err := os.MkdirAll(filepath.Dir(shared), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(shared, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	de := filepath.Join(dir, "de.yml")
+	if err := os.Symlink(filepath.Join("shared", "de.yml"), de); err != nil {
+		t.Fatal(err)
+	}
+
+	target := getBaseTarget()
+	target.File = filepath.Join(dir, "<locale_code>.yml")
+	clients := newClientPool(context.Background(), &phraseapp.Credentials{Host: srv.URL})
+	if err := target.Pull(clients, LocaleCache{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for path, expected := range map[string]os.FileMode{en: 0644, shared: 0600} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != expected {
+			t.Errorf("expected %s to keep mode %v, got %v", path, expected, info.Mode().Perm())
+		}
+		if content, _ := ioutil.ReadFile(path); string(content) != "new: content\n" {
+			t.Errorf("expected %s to be updated, got %q", path, content)
+		}
+	}
+	if info, err := os.Lstat(de); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("expected %s to stay a symlink, got %v (%v)", de, info, err)
+	}
+	if info, err := os.Stat(filepath.Join(dir, "fr.yml")); err != nil || info.Mode().Perm()&^localeFileMode != 0 {
+		t.Errorf("expected fr.yml to be created with a mode within %v, got %v (%v)", localeFileMode, info, err)
+	}
+}
+
+func TestPullOutDirFromSubdirectory(t *testing.T) {
+	defer os.Setenv("PHRASEAPP_CONFIG", os.Getenv("PHRASEAPP_CONFIG"))
+	defer func(quiet bool) { Quiet = quiet }(Quiet)
+	defer func() { OutDir = "" }()
+	Quiet = true
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/download") {
+			io.WriteString(w, "en: content\n")
+			return
+		}
+		io.WriteString(w, `[{"id":"en-locale-id","code":"en","name":"english"}]`)
+	}))
+	defer srv.Close()
+
+	root, err := ioutil.TempDir("", "phraseapp-pull")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	if root, err = filepath.EvalSymlinks(root); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("PHRASEAPP_CONFIG", "")
+	for _, dir := range []string{".git", "app/src"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, configName), []byte("phraseapp:\n  out_dir: out\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(filepath.Join(root, "app", "src")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	target := getBaseTarget()
+	target.File = "locales/<locale_code>.yml"
+	target.OutDir = targetOutDir("")
+	clients := newClientPool(context.Background(), &phraseapp.Credentials{Host: srv.URL})
+	if err := target.Pull(clients, LocaleCache{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := filepath.Join(root, "out", "locales", "en.yml")
+	if content, err := ioutil.ReadFile(expected); err != nil || string(content) != "en: content\n" {
+		t.Errorf("expected the locale file in the out_dir next to the configuration, got %q (%v)", content, err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "app", "src", "out")); !os.IsNotExist(err) {
+		t.Errorf("expected nothing to be written below the working directory, got %v", err)
+	}
+
+	if outDir := targetOutDir("build"); outDir != "build" {
+		t.Errorf("expected --out-dir to stay relative to the working directory, got %s", outDir)
+	}
+}
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/gopkg.in/yaml.v2"
 
@@ -16,40 +20,161 @@ import (
 
 type PushCommand struct {
 	*phraseapp.Config
+
+	Tags          []string `cli:"opt --tag desc='tag all uploaded keys, separate multiple tags with commas'"`
+	SkipUnchanged bool     `cli:"opt --skip-unchanged desc='skip files uploaded with the same content and parameters before'"`
+
+	CreateMissingLocales bool `cli:"opt --create-missing-locales desc='create the locales of all files whose <locale_code> does not exist yet before uploading'"`
+
+	Wait         bool   `cli:"opt --wait desc='wait until every upload has been processed, fails if processing fails'"`
+	PollInterval string `cli:"opt --poll-interval default=2s desc='time between checks of the upload state with --wait'"`
+
+	Watch bool `cli:"opt --watch desc='upload files when they change until interrupted with Ctrl-C'"`
+
+	KeepBOM bool `cli:"opt --keep-bom desc='upload files with their UTF-8 byte order mark instead of removing it (also available as strip_bom: false)'"`
+
+	UpdateDescriptions bool   `cli:"opt --update-descriptions desc='update descriptions, character limits and tags of keys from the metadata file after uploading'"`
+	Metadata           string `cli:"opt --metadata desc='CSV or JSON file with the key metadata in the format of keys/import (also available as metadata configuration key of sources)'"`
+
+	FailFast  bool `cli:"opt --fail-fast desc='stop at the first file failing to upload'"`
+	KeepGoing bool `cli:"opt --keep-going desc='upload the other files if one fails and report the failures at the end, the default'"`
 }
 
 func (cmd *PushCommand) Run() error {
+	return cmd.RunContext(commandContext)
+}
+
+// RunContext pushes until ctx is done, which aborts the requests in flight.
+func (cmd *PushCommand) RunContext(ctx context.Context) error {
 	if cmd.Debug {
 		// suppresses content output
 		cmd.Debug = false
 		Debug = true
 	}
+	push := func() error {
+		return cmd.push(ctx)
+	}
+	if !cmd.Watch {
+		return push()
+	}
+
+	sources, err := SourcesFromConfig(cmd)
+	if err != nil {
+		return &invalidError{err}
+	}
+	if err := push(); err != nil {
+		return err
+	}
+
+	// Later pushes only upload the files changed since.
+	cmd.SkipUnchanged = true
+	return watchFiles(ctx, func() (fileStates, error) {
+		return sources.fileStates()
+	}, push)
+}
+
+// fileStates returns the state of all files matching the sources' patterns.
+func (sources Sources) fileStates() (fileStates, error) {
+	paths := []string{}
+	for _, source := range sources {
+		files, err := source.SystemFiles()
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, files...)
+	}
+	return statFiles(paths)
+}
+
+// uploadCache returns the cache recording the checksums of uploaded files,
+// which is only needed to skip unchanged files with --skip-unchanged or
+// --watch. It's nil otherwise, so a plain push doesn't write the cache.
+func (cmd *PushCommand) uploadCache() *Cache {
+	if !cmd.SkipUnchanged && !cmd.Watch {
+		return nil
+	}
+	return LoadCache(cachePath())
+}
 
-	client, err := newClient(cmd.Config.Credentials)
+func (cmd *PushCommand) push(ctx context.Context) error {
+	client, err := newClientContext(ctx, cmd.Config.Credentials)
 	if err != nil {
 		return err
 	}
 
 	sources, err := SourcesFromConfig(cmd)
 	if err != nil {
+		return &invalidError{err}
+	}
+
+	if err := sources.loadFormats(client); err != nil {
 		return err
 	}
 
-	formats, err := client.FormatsList(1, 25)
-	if err == nil {
-		err = sources.setFormats(formats)
-		if err != nil {
+	ignore, err := LoadIgnoreRules(configDir())
+	if err != nil {
+		return err
+	}
+
+	uploads := cmd.uploadCache()
+
+	var pollInterval time.Duration
+	if cmd.Wait {
+		if pollInterval, err = parsePollInterval(cmd.PollInterval); err != nil {
+			return &invalidError{err}
+		}
+	}
+
+	var syncs []*metadataSync
+	if cmd.UpdateDescriptions {
+		if syncs, err = sources.metadataSyncs(cmd.Metadata); err != nil {
+			return &invalidError{err}
 		}
 	}
 
+	clients := newClientPool(ctx, cmd.Config.Credentials)
+	uploaded := 0
+	failures, err := newFailures(cmd.FailFast, cmd.KeepGoing)
+	if err != nil {
+		return err
+	}
 	for _, source := range sources {
+		source.Ignore = ignore
+		source.Tags = cmd.Tags
+		source.Uploads = uploads
+		source.SkipUnchanged = cmd.SkipUnchanged
+		source.CreateMissingLocales = cmd.CreateMissingLocales
+		source.PollInterval = pollInterval
+		source.Failures = failures
+		if cmd.KeepBOM {
+			source.KeepBOM = true
+		}
 
-		err := source.Push(client)
+		err := source.Push(clients)
+		uploaded += source.Uploaded
 		if err != nil {
+			if failures.Err() != nil {
+				logger.Errorf("%s", failures)
+			}
+			if uploaded > 0 {
+				return &partialError{err}
+			}
 			return err
 		}
 	}
-	return nil
+
+	for _, sync := range syncs {
+		if err := sync.run(clients); err != nil {
+			if failures.Err() != nil {
+				logger.Errorf("%s", failures)
+			}
+			if uploaded > 0 {
+				return &partialError{err}
+			}
+			return err
+		}
+	}
+	return failures.Err()
 }
 
 type Sources []*Source
@@ -63,20 +188,47 @@ type Source struct {
 
 	RemoteLocales []*phraseapp.Locale
 	Format        *phraseapp.Format
+	Ignore        *IgnoreRules
+	Tags          []string
+	Uploads       *Cache
+	SkipUnchanged bool
+
+	CreateMissingLocales bool
+
+	// PollInterval is the time between checks of an upload's state. Zero
+	// means uploads aren't waited for.
+	PollInterval time.Duration
+
+	// Uploaded counts the files uploaded by Push.
+	Uploaded int
+	// Failures collects the files Push failed for.
+	Failures *MultiError
+
+	// KeepBOM uploads files starting with a UTF-8 byte order mark as they
+	// are, by default the mark is removed.
+	KeepBOM bool
+
+	// Metadata is the file with the key metadata applied with
+	// --update-descriptions.
+	Metadata string
 }
 
 func (src *Source) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	m := map[string]interface{}{}
+	stripBOM := true
 	err := phraseapp.ParseYAMLToMap(unmarshal, map[string]interface{}{
 		"file":         &src.File,
 		"project_id":   &src.ProjectID,
 		"access_token": &src.AccessToken,
 		"file_format":  &src.FileFormat,
+		"strip_bom":    &stripBOM,
+		"metadata":     &src.Metadata,
 		"params":       &m,
 	})
 	if err != nil {
 		return err
 	}
+	src.KeepBOM = !stripBOM
 
 	src.Params = new(phraseapp.UploadParams)
 	return src.Params.ApplyValuesFromMap(m)
@@ -85,19 +237,30 @@ func (src *Source) UnmarshalYAML(unmarshal func(interface{}) error) error {
 var separator = string(os.PathSeparator)
 
 func (source *Source) CheckPreconditions() error {
-	if err := ValidPath(source.File, source.FileFormat, ""); err != nil {
+	for _, pattern := range expandBraces(source.File) {
+		if err := checkPushPattern(pattern, source.FileFormat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPushPattern checks a file pattern of a source, after braces were
+// expanded.
+func checkPushPattern(file, fileFormat string) error {
+	if err := ValidPath(file, fileFormat, ""); err != nil {
 		return err
 	}
 
 	duplicatedPlaceholders := []string{}
-	for _, name := range []string{"<locale_name>", "<locale_code>", "<tag>"} {
-		if strings.Count(source.File, name) > 1 {
+	for _, name := range []string{"<locale_name>", "<locale_code>", "<tag>", "<branch>"} {
+		if strings.Count(file, name) > 1 {
 			duplicatedPlaceholders = append(duplicatedPlaceholders, name)
 		}
 	}
 
-	starCount := strings.Count(source.File, "*")
-	recCount := strings.Count(source.File, "**")
+	starCount := strings.Count(file, "*")
+	recCount := strings.Count(file, "**")
 
 	// starCount contains the `**` so that must be taken into account.
 	if starCount-(recCount*2) > 1 {
@@ -116,11 +279,16 @@ func (source *Source) CheckPreconditions() error {
 	return nil
 }
 
-func (source *Source) Push(client *phraseapp.Client) error {
+func (source *Source) Push(clients *clientPool) error {
 	if err := source.CheckPreconditions(); err != nil {
 		return err
 	}
 
+	client, err := clients.Client(source.AccessToken)
+	if err != nil {
+		return fmt.Errorf("%s for source %s", err, source.File)
+	}
+
 	remoteLocales, err := RemoteLocales(client, source.ProjectID)
 	if err != nil {
 		return err
@@ -132,9 +300,14 @@ func (source *Source) Push(client *phraseapp.Client) error {
 		return err
 	}
 
-	for _, localeFile := range localeFiles {
-		fmt.Println("Uploading", localeFile.RelPath())
+	if source.CreateMissingLocales {
+		if err := source.createMissingLocales(client, localeFiles); err != nil {
+			return err
+		}
+	}
 
+	source.Failures.count(len(localeFiles))
+	for _, localeFile := range localeFiles {
 		if localeFile.shouldCreateLocale(source) {
 			localeDetails, err := source.createLocale(client, localeFile)
 			if err == nil {
@@ -142,24 +315,90 @@ func (source *Source) Push(client *phraseapp.Client) error {
 				localeFile.Code = localeDetails.Code
 				localeFile.Name = localeDetails.Name
 			} else {
-				fmt.Printf("failed to create locale: %s\n", err)
+				if clients.aborted() {
+					return err
+				}
+				if err := source.Failures.Add(localeFile, fmt.Errorf("failed to create locale: %s", err)); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		key, sum := uploadCacheKey(source.ProjectID, localeFile.Path), ""
+		if source.Uploads != nil {
+			var err error
+			if sum, err = source.uploadChecksum(localeFile); err != nil {
+				if err := source.Failures.Add(localeFile, err); err != nil {
+					return err
+				}
 				continue
 			}
 		}
+		if source.SkipUnchanged && source.Uploads.Uploaded(key, sum) {
+			if !Quiet {
+				fmt.Println("Skipped", localeFile.RelPath(), "(unchanged since last upload)")
+			}
+			continue
+		}
 
-		err = source.uploadFile(client, localeFile)
+		if !Quiet {
+			fmt.Println("Uploading", localeFile.RelPath())
+		}
+
+		err := source.uploadFile(client, localeFile)
 		if err != nil {
-			return err
+			if clients.aborted() {
+				return err
+			}
+			if err := source.Failures.Add(localeFile, err); err != nil {
+				return err
+			}
+			continue
+		}
+		source.Uploaded++
+
+		// Saved after every upload, so an interrupted push can be resumed.
+		source.Uploads.StoreUpload(key, sum)
+		if err := source.Uploads.Save(); err != nil {
+			logger.Warnf("failed to write cache: %s", err)
 		}
 
 		sharedMessage("push", localeFile)
+	}
+
+	return nil
+}
+
+// createMissingLocales creates a locale for every locale code of the files not
+// found in the project. Each locale is created once, even if several files
+// share its code.
+func (source *Source) createMissingLocales(client *phraseapp.Client, localeFiles LocaleFiles) error {
+	created := map[string]*phraseapp.LocaleDetails{}
+	for _, localeFile := range localeFiles {
+		if localeFile.ExistsRemote || localeFile.Code == "" {
+			continue
+		}
 
-		if Debug {
-			fmt.Fprintln(os.Stderr, strings.Repeat("-", 10))
+		locale, found := created[localeFile.Code]
+		if !found {
+			var err error
+			locale, err = source.createLocale(client, localeFile)
+			if err != nil {
+				return fmt.Errorf("failed to create locale %s for %s: %s", localeFile.Code, localeFile.RelPath(), err)
+			}
+			created[localeFile.Code] = locale
+			source.RemoteLocales = append(source.RemoteLocales, &locale.Locale)
+			if !Quiet {
+				fmt.Printf("Created locale %s (%s)\n", locale.Code, locale.ID)
+			}
 		}
 
+		localeFile.ID = locale.ID
+		localeFile.Code = locale.Code
+		localeFile.Name = locale.Name
+		localeFile.ExistsRemote = true
 	}
-
 	return nil
 }
 
@@ -200,11 +439,42 @@ func (source *Source) replacePlaceholderInParams(localeFile *LocaleFile) string
 }
 
 func (source *Source) uploadFile(client *phraseapp.Client, localeFile *LocaleFile) error {
-	if Debug {
-		fmt.Fprintln(os.Stdout, "Source file pattern:", source.File)
-		fmt.Fprintln(os.Stdout, "Actual file location:", localeFile.Path)
+	logger.Debugf("Source file pattern: %s", source.File)
+	logger.Debugf("Actual file location: %s", localeFile.Path)
+
+	if showProgress() {
+		client = progressClient(client, localeFile.RelPath(), true)
+	}
+
+	params := source.uploadParams(localeFile)
+	if !source.KeepBOM {
+		dir, path, err := stripBOMUpload(localeFile.Path)
+		if err != nil {
+			return err
+		}
+		if dir != "" {
+			defer os.RemoveAll(dir)
+		}
+		params.File = &path
+	}
+
+	upload, err := client.UploadCreate(source.ProjectID, params)
+	if err != nil || source.PollInterval == 0 {
+		return err
+	}
+
+	upload, err = waitForUpload(client, source.ProjectID, upload, source.PollInterval)
+	if err != nil {
+		return err
 	}
+	if !Quiet {
+		fmt.Println(uploadSummary(upload))
+	}
+	return nil
+}
 
+// uploadParams returns the parameters localeFile is uploaded with.
+func (source *Source) uploadParams(localeFile *LocaleFile) *phraseapp.UploadParams {
 	params := new(phraseapp.UploadParams)
 	*params = *source.Params
 
@@ -219,21 +489,74 @@ func (source *Source) uploadFile(client *phraseapp.Client, localeFile *LocaleFil
 		}
 	}
 
+	tags := []string{}
+	if params.Tags != nil && *params.Tags != "" {
+		tags = append(tags, *params.Tags)
+	}
 	if localeFile.Tag != "" {
-		var v string
-		if params.Tags != nil {
-			v = *params.Tags + ","
-		}
-		v += localeFile.Tag
+		tags = append(tags, localeFile.Tag)
+	}
+	tags = append(tags, source.Tags...)
+	if len(tags) > 0 {
+		v := strings.Join(tags, ",")
 		params.Tags = &v
+		localeFile.UploadTags = v
 	}
+	return params
+}
 
-	_, err := client.UploadCreate(source.ProjectID, params)
-	return err
+func uploadCacheKey(projectID, path string) string {
+	return projectID + "|" + path
 }
 
+// uploadChecksum identifies the content of localeFile together with the
+// parameters it's uploaded with, so changing either results in an upload.
+func (source *Source) uploadChecksum(localeFile *LocaleFile) (string, error) {
+	content, err := ioutil.ReadFile(localeFile.Path)
+	if err != nil {
+		return "", err
+	}
+	params := source.uploadParams(localeFile)
+	params.File = nil
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	return checksum(append(content, encoded...)), nil
+}
+
+// pattern returns the file pattern with the <branch> placeholder substituted.
+func (source *Source) pattern() string {
+	return strings.Replace(source.File, "<branch>", Branch, -1)
+}
+
+// patterns returns the file patterns resulting from expanding the braces in
+// pattern.
+func (source *Source) patterns() []string {
+	return expandBraces(source.pattern())
+}
+
+// SystemFiles returns the files matching any of the source's patterns.
 func (source *Source) SystemFiles() ([]string, error) {
-	pattern := placeholderRegexp.ReplaceAllString(source.File, "*")
+	files := []string{}
+	seen := map[string]bool{}
+	for _, pattern := range source.patterns() {
+		matches, err := systemFiles(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			if !seen[match] {
+				seen[match] = true
+				files = append(files, match)
+			}
+		}
+	}
+	return files, nil
+}
+
+func systemFiles(pattern string) ([]string, error) {
+	pattern = placeholderRegexp.ReplaceAllString(pattern, "*")
 	parts := strings.SplitN(pattern, "**", 2)
 	var pre, post string
 
@@ -262,7 +585,7 @@ func (source *Source) SystemFiles() ([]string, error) {
 
 	var matches []string
 	if post != "" {
-		tokens := splitPathIntoSegments(strings.Replace(post, "*", ".*", -1))
+		tokens := splitPathIntoSegments(post)
 		tokenCountPre := len(splitPathIntoSegments(pre))
 
 		for _, cand := range candidates {
@@ -309,12 +632,9 @@ func validateFileCandidate(tokens []string, ignoreTokenCnt int, cand string) boo
 	for i := 1; i <= len(tokens); i++ {
 		expT, gotT := tokens[len(tokens)-i], candTokens[len(candTokens)-i]
 		switch {
-		case strings.Contains(expT, "*"):
-			matched, err := regexp.MatchString(expT, gotT)
-			if err != nil {
-				panic(err)
-			}
-			if !matched {
+		case hasGlobMeta(expT):
+			expr, err := globSegmentRegexp(expT)
+			if err != nil || !expr.MatchString(gotT) {
 				return false
 			}
 		case expT != gotT:
@@ -354,16 +674,30 @@ func findFilesInPath(root string) ([]string, error) {
 
 // Return all locale files from disk that match the source pattern.
 func (source *Source) LocaleFiles() (LocaleFiles, error) {
-
-	filePaths, err := source.SystemFiles()
-	if err != nil {
-		return nil, err
+	// The placeholders of a file are read with the first pattern matching it.
+	filePaths := []string{}
+	patterns := map[string]string{}
+	for _, pattern := range source.patterns() {
+		matches, err := systemFiles(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range matches {
+			if _, found := patterns[path]; !found {
+				patterns[path] = pattern
+				filePaths = append(filePaths, path)
+			}
+		}
 	}
 
-	tokens := splitPathToTokens(source.File)
+	filePaths, skipped := source.Ignore.Filter(filePaths)
+	if skipped > 0 && !Quiet {
+		fmt.Printf("Skipped %d file(s) matching rules in %s\n", skipped, ignoreFileName)
+	}
 
 	var localeFiles LocaleFiles
 	for _, path := range filePaths {
+		tokens := splitPathToTokens(patterns[path])
 		pathTokens := splitPathToTokens(path)
 		localeFile := extractParamsFromPathTokens(tokens, pathTokens)
 
@@ -382,20 +716,15 @@ func (source *Source) LocaleFiles() (LocaleFiles, error) {
 			localeFile.ID = locale.ID
 		}
 
-		if Debug {
-			fmt.Printf(
-				"Code:%q, Name:%q, ID:%q, Tag:%q\n",
-				localeFile.Code, localeFile.Name, localeFile.ID, localeFile.Tag,
-			)
-		}
+		logger.Debugf("Code:%q, Name:%q, ID:%q, Tag:%q", localeFile.Code, localeFile.Name, localeFile.ID, localeFile.Tag)
 
 		localeFiles = append(localeFiles, localeFile)
 	}
 
 	if len(localeFiles) <= 0 {
-		abs, err := filepath.Abs(source.File)
+		abs, err := filepath.Abs(source.pattern())
 		if err != nil {
-			abs = source.File
+			abs = source.pattern()
 		}
 		return nil, fmt.Errorf("Could not find any files on your system that matches: '%s'", abs)
 	}
@@ -581,7 +910,7 @@ func extractParamFromPathToken(localeFile *LocaleFile, srcToken, pathToken strin
 
 func SourcesFromConfig(cmd *PushCommand) (Sources, error) {
 	if cmd.Config.Sources == nil || len(cmd.Config.Sources) == 0 {
-		return nil, fmt.Errorf("no sources for upload specified")
+		return nil, fmt.Errorf("no sources for upload specified%s", configHint())
 	}
 
 	tmp := struct {
@@ -602,7 +931,7 @@ func SourcesFromConfig(cmd *PushCommand) (Sources, error) {
 		if source == nil {
 			continue
 		}
-		if source.ProjectID == "" {
+		if source.ProjectID == "" || ProjectIDOverride != "" {
 			source.ProjectID = projectId
 		}
 		if source.AccessToken == "" {
@@ -611,6 +940,13 @@ func SourcesFromConfig(cmd *PushCommand) (Sources, error) {
 		if source.Params == nil {
 			source.Params = new(phraseapp.UploadParams)
 		}
+		if overrides != nil && overrides.PushParams != nil {
+			if err := source.Params.ApplyValuesFromMap(overrides.PushParams); err != nil {
+				return nil, fmt.Errorf("invalid push.params given with --stdin-yaml: %s", err)
+			}
+		}
+		source.File = resolvePattern(source.File)
+		warnMissingBranch(source.File)
 
 		if source.Params.FileFormat == nil {
 			switch {
@@ -647,6 +983,25 @@ func (source *Source) GetFileFormat() string {
 	return ""
 }
 
+// loadFormats fetches the formats and sets them on the sources. Failing to
+// fetch them is an error if a source's file format has to be detected from
+// its extension, and a warning otherwise.
+func (sources Sources) loadFormats(client *phraseapp.Client) error {
+	formats, err := client.FormatsList(1, maxPerPage)
+	if err != nil {
+		for _, source := range sources {
+			if source.GetFileFormat() == "" {
+				return fmt.Errorf("failed to list formats to detect the file format of source %s: %s", source.File, err)
+			}
+		}
+		return warnf("failed to list formats: %s", err)
+	}
+	if err := sources.setFormats(formats); err != nil {
+		return &invalidError{err}
+	}
+	return nil
+}
+
 func (sources Sources) setFormats(formats []*phraseapp.Format) error {
 	formatMap := map[string]*phraseapp.Format{}
 	for _, format := range formats {
@@ -655,6 +1010,19 @@ func (sources Sources) setFormats(formats []*phraseapp.Format) error {
 
 	for _, source := range sources {
 		formatName := source.GetFileFormat()
+		if formatName == "" {
+			format, err := detectFormat(formats, source.File)
+			if err != nil {
+				return err
+			}
+			if source.Params == nil {
+				source.Params = new(phraseapp.UploadParams)
+			}
+			source.Params.FileFormat = &format.ApiName
+			source.Format = format
+			logger.Debugf("Detected format %s for source %s", format.ApiName, source.File)
+			continue
+		}
 		if val, ok := formatMap[formatName]; ok {
 			source.Format = val
 		}
@@ -663,6 +1031,32 @@ func (sources Sources) setFormats(formats []*phraseapp.Format) error {
 	return nil
 }
 
+// detectFormat returns the importable format using the extension of file, for
+// sources without file_format.
+func detectFormat(formats []*phraseapp.Format, file string) (*phraseapp.Format, error) {
+	extension := strings.TrimPrefix(filepath.Ext(file), ".")
+	if extension == "" || strings.Contains(extension, "<") {
+		return nil, fmt.Errorf("no file_format set for source %s and it can't be detected from the file extension", file)
+	}
+
+	candidates := []*phraseapp.Format{}
+	names := []string{}
+	for _, format := range formats {
+		if format.Importable && strings.EqualFold(format.Extension, extension) {
+			candidates = append(candidates, format)
+			names = append(names, format.ApiName)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("no format uses the extension %q of source %s, please set file_format", extension, file)
+	case 1:
+		return candidates[0], nil
+	}
+	return nil, fmt.Errorf("several formats use the extension %q of source %s (%s), please set file_format", extension, file, strings.Join(names, ", "))
+}
+
 func (localeFile *LocaleFile) shouldCreateLocale(source *Source) bool {
 	if localeFile.ExistsRemote {
 		return false
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -770,6 +771,35 @@ func TestUploadFile(t *testing.T) {
 	}
 }
 
+func TestUploadFileCommandTags(t *testing.T) {
+	d := setupFiles(t, "a/b/c/d.txt")
+	defer os.RemoveAll(d)
+	th := new(testHandler)
+
+	srv := httptest.NewServer(th)
+	defer srv.Close()
+
+	src := new(Source)
+	src.Params = new(phraseapp.UploadParams)
+	src.Tags = []string{"import", "2016-05"}
+
+	file := new(LocaleFile)
+	file.Path = filepath.Join(d, "a/b/c/d.txt")
+	file.ID = "locale_id"
+
+	if err := src.uploadFile(newTestClient(srv.URL), file); err != nil {
+		t.Errorf("didn't expect an error, got: %s", err)
+	}
+
+	expTags := "import,2016-05"
+	if th.lastTag != expTags {
+		t.Errorf("expected tag %q, got %q", expTags, th.lastTag)
+	}
+	if file.UploadTags != expTags {
+		t.Errorf("expected upload tags %q to be recorded, got %q", expTags, file.UploadTags)
+	}
+}
+
 func TestRemoteLocaleForLocaleFile(t *testing.T) {
 	rlEN := &phraseapp.Locale{ID: "en-locale-id", Name: "english", Code: "en"}
 	rlDE := &phraseapp.Locale{ID: "de-locale-id", Name: "deutsch", Code: "de"}
@@ -852,6 +882,43 @@ func TestSetFormats(t *testing.T) {
 
 }
 
+func TestDetectFormat(t *testing.T) {
+	formats := []*phraseapp.Format{
+		{ApiName: "yml", Extension: "yml", Importable: true},
+		{ApiName: "yml_symfony", Extension: "yml", Importable: true},
+		{ApiName: "json", Extension: "json", Importable: true},
+		{ApiName: "csv", Extension: "csv", Importable: true},
+		{ApiName: "xlsx_export", Extension: "csv", Importable: false},
+	}
+
+	for file, expected := range map[string]string{"./locales/<locale_code>.json": "json", "./<locale_name>.CSV": "csv"} {
+		format, err := detectFormat(formats, file)
+		if err != nil {
+			t.Errorf("%s: %s", file, err)
+		} else if format.ApiName != expected {
+			t.Errorf("%s: expected format %s, got %s", file, expected, format.ApiName)
+		}
+	}
+
+	_, err := detectFormat(formats, "./locales/<locale_code>.yml")
+	if err == nil || !strings.Contains(err.Error(), "yml, yml_symfony") {
+		t.Errorf("expected an error listing the candidates, got %v", err)
+	}
+	for _, file := range []string{"./locales/<locale_code>.po", "./locales/file.<locale_code>", "./locales/file"} {
+		if _, err := detectFormat(formats, file); err == nil {
+			t.Errorf("%s: expected an error", file)
+		}
+	}
+
+	sources := Sources{{File: "./locales/<locale_code>.json"}}
+	if err := sources.setFormats(formats); err != nil {
+		t.Fatal(err)
+	}
+	if sources[0].GetFileFormat() != "json" || sources[0].Format == nil {
+		t.Errorf("expected the json format to be detected, got %q", sources[0].GetFileFormat())
+	}
+}
+
 type patternShouldCreateLocale struct {
 	Name         string
 	Code         string
@@ -930,3 +997,123 @@ func TestShouldCreateLocale(t *testing.T) {
 		}
 	}
 }
+
+func TestUploadChecksum(t *testing.T) {
+	d := setupFiles(t, "en.yml")
+	defer os.RemoveAll(d)
+
+	src := new(Source)
+	src.Params = new(phraseapp.UploadParams)
+	file := &LocaleFile{Path: filepath.Join(d, "en.yml"), ID: "en-id"}
+
+	sum, err := src.uploadChecksum(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache := LoadCache(filepath.Join(d, cacheName))
+	cache.StoreUpload(uploadCacheKey("project-id", file.Path), sum)
+	if err := cache.Save(); err != nil {
+		t.Fatal(err)
+	}
+	cache = LoadCache(filepath.Join(d, cacheName))
+	if !cache.Uploaded(uploadCacheKey("project-id", file.Path), sum) {
+		t.Errorf("expected the upload to be recorded")
+	}
+
+	src.Tags = []string{"new"}
+	if tagged, _ := src.uploadChecksum(file); tagged == sum {
+		t.Errorf("expected the checksum to change with the upload parameters")
+	}
+	src.Tags = nil
+
+	if err := ioutil.WriteFile(file.Path, []byte("en:\n  changed: yes\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if changed, _ := src.uploadChecksum(file); changed == sum {
+		t.Errorf("expected the checksum to change with the file content")
+	}
+}
+
+func TestLoadFormatsFailure(t *testing.T) {
+	oldStrict, oldCount := Strict, warnings.count
+	defer func() {
+		Strict, warnings.count = oldStrict, oldCount
+	}()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, `{"message":"formats unavailable"}`)
+	}))
+	defer srv.Close()
+	client := newTestClient(srv.URL)
+
+	Strict = false
+	detected := Sources{{File: "./locales/<locale_code>.json"}}
+	if err := detected.loadFormats(client); err == nil || !strings.Contains(err.Error(), "detect the file format of source ./locales/<locale_code>.json") {
+		t.Errorf("expected an error for the source whose format can't be detected, got %v", err)
+	}
+
+	configured := Sources{{File: "./locales/<locale_code>.json", FileFormat: "json"}}
+	if err := configured.loadFormats(client); err != nil {
+		t.Errorf("expected a warning only for a source with file format, got %v", err)
+	}
+	Strict = true
+	if err := configured.loadFormats(client); err == nil {
+		t.Errorf("expected the warning to fail with --strict")
+	}
+}
+
+func TestPushUploadCache(t *testing.T) {
+	if cache := (&PushCommand{}).uploadCache(); cache != nil {
+		t.Errorf("expected no upload cache for a plain push")
+	}
+	for _, cmd := range []*PushCommand{{SkipUnchanged: true}, {Watch: true}} {
+		if cmd.uploadCache() == nil {
+			t.Errorf("expected an upload cache for %+v", cmd)
+		}
+	}
+}
+
+func TestCreateMissingLocales(t *testing.T) {
+	created := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || !strings.HasSuffix(r.URL.Path, "/locales") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		params := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&params)
+		code := params["code"]
+		created = append(created, code)
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id":"`+code+`-locale-id","code":"`+code+`","name":"`+code+`"}`)
+	}))
+	defer srv.Close()
+
+	source := getBaseSource()
+	localeFiles := LocaleFiles{
+		{Path: "en/a.yml", Code: "en", ID: "en-locale-id", ExistsRemote: true},
+		{Path: "fr/a.yml", Code: "fr"},
+		{Path: "fr/b.yml", Code: "fr"},
+		{Path: "unknown.yml"},
+	}
+
+	if err := source.createMissingLocales(newTestClient(srv.URL), localeFiles); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(created, ",") != "fr" {
+		t.Errorf("expected the fr locale to be created once, got %v", created)
+	}
+	for _, localeFile := range localeFiles[1:3] {
+		if !localeFile.ExistsRemote || localeFile.ID != "fr-locale-id" {
+			t.Errorf("expected %s to use the created locale, got %#v", localeFile.Path, localeFile)
+		}
+	}
+	if localeFiles[3].ExistsRemote {
+		t.Errorf("expected no locale to be created for a file without locale code")
+	}
+	if len(source.RemoteLocales) != len(getBaseLocales())+1 {
+		t.Errorf("expected the created locale to be added to the remote locales, got %d", len(source.RemoteLocales))
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// withTags adds a tags qualifier for the given tags to the search query q. The
+// API matches translations whose key has any of the tags, so several tags are
+// combined with OR. Returns q unchanged if there are no tags.
+func withTags(q *string, tags []string) (*string, error) {
+	if len(tags) == 0 {
+		return q, nil
+	}
+	for _, tag := range tags {
+		if tag == "" || strings.ContainsAny(tag, " \t\n,") {
+			return nil, fmt.Errorf("invalid tag %q, tags must not be empty or contain spaces or commas", tag)
+		}
+	}
+
+	query := "tags:" + strings.Join(tags, ",")
+	if q != nil && *q != "" {
+		query = *q + " " + query
+	}
+	return &query, nil
+}
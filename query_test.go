@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestWithTags(t *testing.T) {
+	q := "name:foo"
+	for _, tc := range []struct {
+		q        *string
+		tags     []string
+		expected string
+	}{
+		{nil, []string{"feature"}, "tags:feature"},
+		{nil, []string{"feature", "center"}, "tags:feature,center"},
+		{&q, []string{"feature"}, "name:foo tags:feature"},
+		{&q, nil, "name:foo"},
+	} {
+		res, err := withTags(tc.q, tc.tags)
+		if err != nil {
+			t.Errorf("tags %v: %s", tc.tags, err)
+		} else if *res != tc.expected {
+			t.Errorf("tags %v: expected query %q, got %q", tc.tags, tc.expected, *res)
+		}
+	}
+
+	if res, _ := withTags(nil, nil); res != nil {
+		t.Errorf("expected no query without tags, got %q", *res)
+	}
+
+	for _, tags := range [][]string{{""}, {"a b"}, {"a,b"}} {
+		if _, err := withTags(nil, tags); err == nil {
+			t.Errorf("expected an error for tags %q", tags)
+		}
+	}
+}
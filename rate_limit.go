@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// requestLimiter throttles all requests to the rate given with --rps. Nil
+// means requests aren't throttled.
+var requestLimiter *rateLimiter
+
+// parseRPS parses the value of --rps, the number of requests per second. It
+// can be a fraction, e.g. 0.5 for a request every two seconds.
+func parseRPS(value string) (*rateLimiter, error) {
+	rps, err := strconv.ParseFloat(value, 64)
+	if err != nil || rps <= 0 {
+		return nil, fmt.Errorf("%q is no positive number of requests per second", value)
+	}
+	return newRateLimiter(rps), nil
+}
+
+// rateLimiter is a token bucket holding a single token, refilled every
+// interval. Every request takes the token, so requests are spaced evenly
+// instead of being sent in bursts. It's shared by all clients, so parallel
+// requests are throttled together.
+type rateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time // when the token is available again
+}
+
+func newRateLimiter(rps float64) *rateLimiter {
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / rps)}
+}
+
+// reserve takes the token and returns how long to wait for it.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	return wait
+}
+
+// rateLimitTransport waits for the rate limiter before sending a request.
+// Waiting is aborted with the request's context.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *rateLimiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if wait := t.limiter.reserve(time.Now()); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+	return t.next.RoundTrip(req)
+}
+
+// rateLimitStatus describes the API's rate limit headers of a response, e.g.
+// "rate limit: 998 of 1000 requests left, reset at 15:04:05". Returns an
+// empty string if the response has none.
+func rateLimitStatus(header http.Header) string {
+	remaining := header.Get("X-Rate-Limit-Remaining")
+	if remaining == "" {
+		return ""
+	}
+	status := fmt.Sprintf("rate limit: %s of %s requests left", remaining, header.Get("X-Rate-Limit-Limit"))
+	if reset, err := strconv.ParseInt(header.Get("X-Rate-Limit-Reset"), 10, 64); err == nil {
+		status += ", reset at " + time.Unix(reset, 0).Format("15:04:05")
+	}
+	return status
+}
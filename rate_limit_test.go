@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRPS(t *testing.T) {
+	limiter, err := parseRPS("0.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if limiter.interval != 2*time.Second {
+		t.Errorf("expected a request every 2s, got %s", limiter.interval)
+	}
+	for _, value := range []string{"0", "-1", "fast"} {
+		if _, err := parseRPS(value); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	limiter := newRateLimiter(4)
+	now := time.Date(2016, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	waits := []time.Duration{}
+	for i := 0; i < 3; i++ {
+		waits = append(waits, limiter.reserve(now))
+	}
+	for i, expected := range []time.Duration{0, 250 * time.Millisecond, 500 * time.Millisecond} {
+		if waits[i] != expected {
+			t.Errorf("request %d: expected to wait %s, got %s", i+1, expected, waits[i])
+		}
+	}
+
+	if wait := limiter.reserve(now.Add(time.Second)); wait != 0 {
+		t.Errorf("expected no wait after an idle second, got %s", wait)
+	}
+}
+
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestRateLimitTransportAborted(t *testing.T) {
+	next := &countingTransport{}
+	tr := &rateLimitTransport{next: next, limiter: newRateLimiter(0.01)}
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tr.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := tr.RoundTrip(req.WithContext(ctx)); err != context.DeadlineExceeded {
+		t.Errorf("expected waiting to be aborted, got %v", err)
+	}
+	if next.requests != 1 {
+		t.Errorf("expected only the first request to be sent, got %d", next.requests)
+	}
+}
+
+func TestRateLimitStatus(t *testing.T) {
+	if status := rateLimitStatus(http.Header{}); status != "" {
+		t.Errorf("expected no status without headers, got %q", status)
+	}
+
+	header := http.Header{}
+	header.Set("X-Rate-Limit-Limit", "1000")
+	header.Set("X-Rate-Limit-Remaining", "998")
+	header.Set("X-Rate-Limit-Reset", "1462881600")
+	expected := "rate limit: 998 of 1000 requests left, reset at " + time.Unix(1462881600, 0).Format("15:04:05")
+	if status := rateLimitStatus(header); status != expected {
+		t.Errorf("expected %q, got %q", expected, status)
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// minSecretLength keeps accidental short values, like a placeholder token in
+// a configuration, from redacting every occurrence of a common string.
+const minSecretLength = 8
+
+// secrets are the access tokens in use, registered with addSecret.
+var secrets struct {
+	sync.Mutex
+	values []string
+}
+
+// addSecret registers a value, like the access token of a client, to be
+// redacted from all output.
+func addSecret(value string) {
+	if len(value) < minSecretLength {
+		return
+	}
+	secrets.Lock()
+	defer secrets.Unlock()
+	for _, known := range secrets.values {
+		if known == value {
+			return
+		}
+	}
+	secrets.values = append(secrets.values, value)
+}
+
+func secretValues() []string {
+	secrets.Lock()
+	defer secrets.Unlock()
+	values := append([]string{}, secrets.values...)
+	if token := os.Getenv("PHRASEAPP_ACCESS_TOKEN"); len(token) >= minSecretLength {
+		values = append(values, token)
+	}
+	return values
+}
+
+var (
+	authorizationRegexp = regexp.MustCompile(`(?i)(authorization:\s*(?:token|bearer|basic)\s+)[^\s"]+`)
+	accessTokenRegexp   = regexp.MustCompile(`(access_token=)[^&\s"]+`)
+)
+
+// redact replaces the access tokens in use, the credentials of Authorization
+// headers and access_token parameters in s, e.g. in an error echoing a request
+// URL, so they don't end up in logs.
+func redact(s string) string {
+	for _, secret := range secretValues() {
+		s = strings.Replace(s, secret, "[REDACTED]", -1)
+	}
+	s = authorizationRegexp.ReplaceAllString(s, "${1}[REDACTED]")
+	return accessTokenRegexp.ReplaceAllString(s, "${1}[REDACTED]")
+}
+
+// redactingWriter redacts access tokens from every write to w. A token split
+// across writes isn't redacted, so it must be wrapped around writers receiving
+// whole messages, like the --verbose output.
+type redactingWriter struct {
+	w io.Writer
+}
+
+func (rw *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(rw.w, redact(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
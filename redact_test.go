@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+const testToken = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func resetSecrets() func() {
+	secrets.Lock()
+	values := secrets.values
+	secrets.values = nil
+	secrets.Unlock()
+	env := os.Getenv("PHRASEAPP_ACCESS_TOKEN")
+	os.Setenv("PHRASEAPP_ACCESS_TOKEN", "")
+	return func() {
+		secrets.Lock()
+		secrets.values = values
+		secrets.Unlock()
+		os.Setenv("PHRASEAPP_ACCESS_TOKEN", env)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	defer resetSecrets()()
+
+	checksum := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	for _, message := range []string{"checksum " + checksum, `ETag: "` + checksum + `"`} {
+		if got := redact(message); got != message {
+			t.Errorf("expected checksums to be kept, got %q", got)
+		}
+	}
+
+	for message, expected := range map[string]string{
+		"GET https://api.phraseapp.com/v2/projects?access_token=secret-value&page=1": "GET https://api.phraseapp.com/v2/projects?access_token=[REDACTED]&page=1",
+		"Authorization: token secret-value":                                          "Authorization: token [REDACTED]",
+		"authorization: Basic dXNlcjpwYXNz":                                          "authorization: Basic [REDACTED]",
+	} {
+		if got := redact(message); got != expected {
+			t.Errorf("expected %q, got %q", expected, got)
+		}
+	}
+
+	message := fmt.Sprintf("invalid token %s for project 0123abcd", testToken)
+	if got := redact(message); got != message {
+		t.Errorf("expected an unknown token to be kept, got %q", got)
+	}
+	addSecret(testToken)
+	addSecret("short")
+	if got := redact(message); got != "invalid token [REDACTED] for project 0123abcd" {
+		t.Errorf("expected the registered token to be redacted, got %q", got)
+	}
+
+	os.Setenv("PHRASEAPP_ACCESS_TOKEN", "env-token-value")
+	if got := redact("token env-token-value"); got != "token [REDACTED]" {
+		t.Errorf("expected the token of the environment to be redacted, got %q", got)
+	}
+
+	out := &bytes.Buffer{}
+	w := &redactingWriter{w: out}
+	n, err := fmt.Fprintf(w, "--> GET /v2/projects/%s\n", testToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len("--> GET /v2/projects/\n")+len(testToken) {
+		t.Errorf("expected the length of the unredacted write, got %d", n)
+	}
+	if strings.Contains(out.String(), testToken) {
+		t.Errorf("expected the token to be redacted, got %q", out)
+	}
+}
+
+func TestReportErrorRedactsTokens(t *testing.T) {
+	defer resetSecrets()()
+	defer SetErrorReporter(errorReporter)
+	defer func() { DisableErrorReporting, ReportFile = false, "" }()
+
+	dir, err := ioutil.TempDir("", "phraseapp-report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	SetErrorReporter(&recordingReporter{})
+	ReportFile = filepath.Join(dir, "errors.json")
+	DisableErrorReporting = true
+
+	cfg := &phraseapp.Config{Credentials: &phraseapp.Credentials{Token: testToken}}
+	ReportError("Some Error", fmt.Errorf("invalid token %s", testToken), cfg)
+	data, err := ioutil.ReadFile(ReportFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), testToken) || !strings.Contains(string(data), "invalid token [REDACTED]") {
+		t.Errorf("expected the token to be redacted from the report, got %s", data)
+	}
+}
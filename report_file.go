@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+)
+
+// ReportFile is the file errors passed to ReportError are appended to
+// (--report-file), in addition to the remote reporting.
+var ReportFile string
+
+// reportCommand is the command recorded with reports, see commandName.
+var reportCommand string
+
+// reportEntry is a line of the report file.
+type reportEntry struct {
+	Time       time.Time `json:"time"`
+	Command    string    `json:"command"`
+	Name       string    `json:"name"`
+	Message    string    `json:"message"`
+	AppVersion string    `json:"app_version"`
+}
+
+// appendReport appends the error as a line of JSON to the file at path,
+// creating it if needed.
+func appendReport(path string, entry *reportEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// commandName returns the command of args, the arguments up to the first
+// option, e.g. "keys list <project_id>". Values of options are left out, as
+// they might contain credentials.
+func commandName(args []string) string {
+	name := []string{}
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			break
+		}
+		name = append(name, arg)
+	}
+	return strings.Join(name, " ")
+}
+
+func writeReport(name, message string) {
+	entry := &reportEntry{
+		Time:       time.Now().UTC(),
+		Command:    reportCommand,
+		Name:       name,
+		Message:    message,
+		AppVersion: PHRASEAPP_CLIENT_VERSION,
+	}
+	if err := appendReport(ReportFile, entry); err != nil {
+		logger.Warnf("failed to write error report to %s: %s", ReportFile, err)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReportErrorToFile(t *testing.T) {
+	defer SetErrorReporter(errorReporter)
+	defer func() { DisableErrorReporting, ReportFile, reportCommand = false, "", "" }()
+
+	dir, err := ioutil.TempDir("", "phraseapp-report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	reporter := &recordingReporter{}
+	SetErrorReporter(reporter)
+	ReportFile = filepath.Join(dir, "errors.json")
+	reportCommand = "pull"
+	DisableErrorReporting = true
+
+	ReportError("Some Error", "boom", nil)
+	ReportError("Other Error", "bang", nil)
+	if len(reporter.names) != 0 {
+		t.Errorf("expected no remote report with error reporting disabled, got %v", reporter.names)
+	}
+
+	data, err := ioutil.ReadFile(ReportFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 reports, got %q", data)
+	}
+	entry := &reportEntry{}
+	if err := json.Unmarshal([]byte(lines[1]), entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Command != "pull" || entry.Name != "Other Error" || entry.Message != "bang" || entry.Time.IsZero() {
+		t.Errorf("unexpected report %+v", entry)
+	}
+}
+
+func TestCommandName(t *testing.T) {
+	for _, tc := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"pull"}, "pull"},
+		{[]string{"keys", "list", "project-id", "--access-token", "secret"}, "keys list project-id"},
+		{[]string{"--access-token", "secret", "push"}, ""},
+		{nil, ""},
+	} {
+		if got := commandName(tc.args); got != tc.expected {
+			t.Errorf("%v: expected %q, got %q", tc.args, tc.expected, got)
+		}
+	}
+}
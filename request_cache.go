@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// requestCache holds the JSON responses to GET requests of the formats and
+// locales lists for the lifetime of the process, so lists like the formats or the locales of a project fetched
+// by several parts of a command are only requested once. Shared by all
+// clients like sharedTransport.
+var requestCache = newResponseCache()
+
+type cachedResponse struct {
+	status     string
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+type responseCache struct {
+	mu        sync.Mutex
+	responses map[string]*cachedResponse
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{responses: map[string]*cachedResponse{}}
+}
+
+func (c *responseCache) get(key string) *cachedResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.responses[key]
+}
+
+func (c *responseCache) set(key string, resp *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses[key] = resp
+}
+
+// reset drops all responses, e.g. after data was changed.
+func (c *responseCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses = map[string]*cachedResponse{}
+}
+
+// cachedPathRegexp matches the paths of the lists that are cached: the formats
+// and the locales of a project. Anything else, e.g. the state of an upload
+// that is polled until it was processed, must always be requested.
+var cachedPathRegexp = regexp.MustCompile(`(^|/)formats$|/projects/[^/]+/locales$`)
+
+// cachingTransport answers GET requests for the paths matching
+// cachedPathRegexp from cache if the same request was sent before. Any request
+// other than a GET resets the cache, as it might change what a GET returns.
+// Conditional requests and responses other than JSON are never cached.
+type cachingTransport struct {
+	next  http.RoundTripper
+	cache *responseCache
+}
+
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != "GET" {
+		t.cache.reset()
+		return t.next.RoundTrip(req)
+	}
+	if !cachedPathRegexp.MatchString(req.URL.Path) || req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return t.next.RoundTrip(req)
+	}
+
+	// The library sends the parameters of GET requests in the body.
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	key := req.URL.String() + "\n" + req.Header.Get("Authorization") + "\n" + string(body)
+
+	if cached := t.cache.get(key); cached != nil {
+		logger.Debugf("Using cached response for GET %s", req.URL)
+		return cached.response(req), nil
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		return resp, err
+	}
+
+	content, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	cached := &cachedResponse{status: resp.Status, statusCode: resp.StatusCode, header: resp.Header, body: content}
+	t.cache.set(key, cached)
+	return cached.response(req), nil
+}
+
+func (cached *cachedResponse) response(req *http.Request) *http.Response {
+	header := http.Header{}
+	for k, v := range cached.header {
+		header[k] = v
+	}
+	return &http.Response{
+		Status:        cached.status,
+		StatusCode:    cached.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(cached.body)),
+		ContentLength: int64(len(cached.body)),
+		Request:       req,
+	}
+}
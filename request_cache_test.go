@@ -0,0 +1,74 @@
+package main
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCachingTransport(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if strings.HasSuffix(r.URL.Path, "/download") {
+			w.Header().Set("Content-Type", "text/yaml")
+		} else {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		}
+		io.WriteString(w, `[{"id":"1"}]`)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &cachingTransport{next: http.DefaultTransport, cache: newResponseCache()}}
+	send := func(method, path, body string) string {
+		req, _ := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		content, _ := ioutil.ReadAll(resp.Body)
+		return string(content)
+	}
+
+	for i := 0; i < 2; i++ {
+		if body := send("GET", "/v2/formats", ""); body != `[{"id":"1"}]` {
+			t.Errorf("unexpected body %q", body)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected the repeated GET to be answered from cache, got %d requests", requests)
+	}
+
+	send("GET", "/v2/formats", `{"page":2}`)
+	if requests != 2 {
+		t.Errorf("expected a GET with other parameters to be sent, got %d requests", requests)
+	}
+
+	send("GET", "/v2/projects/1/locales/2/download", "")
+	send("GET", "/v2/projects/1/locales/2/download", "")
+	if requests != 4 {
+		t.Errorf("expected downloads not to be cached, got %d requests", requests)
+	}
+
+	send("GET", "/v2/projects/1/locales", "")
+	send("GET", "/v2/projects/1/locales", "")
+	if requests != 5 {
+		t.Errorf("expected the locales list to be cached, got %d requests", requests)
+	}
+
+	send("GET", "/v2/projects/1/uploads/3", "")
+	send("GET", "/v2/projects/1/uploads/3", "")
+	if requests != 7 {
+		t.Errorf("expected requests not opting in not to be cached, got %d requests", requests)
+	}
+
+	send("POST", "/v2/projects/1/locales", "{}")
+	send("GET", "/v2/formats", "")
+	if requests != 9 {
+		t.Errorf("expected other requests to reset the cache, got %d requests", requests)
+	}
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const manifestName = ".phraseapp.pull-manifest"
+
+// pullManifest records the files completely downloaded by a pull started with
+// --resume, so they can be skipped when the pull is run again after being
+// interrupted.
+type pullManifest struct {
+	path  string
+	Files map[string]*manifestEntry `json:"files"`
+}
+
+type manifestEntry struct {
+	Size     int64  `json:"size"`
+	Checksum string `json:"checksum"`
+}
+
+func manifestPath() string {
+	return filepath.Join(configDir(), manifestName)
+}
+
+// loadManifest reads the manifest at path. A missing or unreadable manifest
+// results in an empty one.
+func loadManifest(path string) *pullManifest {
+	manifest := &pullManifest{path: path, Files: map[string]*manifestEntry{}}
+	content, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return manifest
+	case err == nil:
+		err = json.Unmarshal(content, manifest)
+	}
+	if err != nil {
+		logger.Warnf("ignoring manifest %s: %s", path, err)
+		manifest.Files = map[string]*manifestEntry{}
+	}
+	if manifest.Files == nil {
+		manifest.Files = map[string]*manifestEntry{}
+	}
+	return manifest
+}
+
+// Completed reports whether the file at path was downloaded completely and
+// hasn't changed since.
+func (manifest *pullManifest) Completed(path string) bool {
+	if manifest == nil {
+		return false
+	}
+	entry, found := manifest.Files[path]
+	if !found {
+		return false
+	}
+	if info, err := os.Stat(path); err != nil || info.Size() != entry.Size {
+		return false
+	}
+	content, err := ioutil.ReadFile(path)
+	return err == nil && checksum(content) == entry.Checksum
+}
+
+// Record marks the file at path as downloaded completely and saves the
+// manifest, so the file is skipped even if the pull is killed.
+func (manifest *pullManifest) Record(path string) error {
+	if manifest == nil {
+		return nil
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	manifest.Files[path] = &manifestEntry{Size: int64(len(content)), Checksum: checksum(content)}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(manifest.path, data, 0600)
+}
+
+// Remove deletes the manifest after a successful pull.
+func (manifest *pullManifest) Remove() error {
+	if manifest == nil {
+		return nil
+	}
+	if err := os.Remove(manifest.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// writeFileAtomic writes content to a temporary file next to path and renames
+// it to path, so path never contains partially written content.
+func writeFileAtomic(path string, content []byte, mode os.FileMode) error {
+	return replaceFile(path, content, mode, true)
+}
+
+// updateFileAtomic writes content to path like writeFileAtomic, but keeps the
+// file in place: a symlink is written through to the file it points to and an
+// existing file keeps its mode. New files are created with mode, minus the
+// umask.
+func updateFileAtomic(path string, content []byte, mode os.FileMode) error {
+	info, err := os.Lstat(path)
+	if err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if path, err = resolveSymlink(path); err != nil {
+			return err
+		}
+		info, err = os.Stat(path)
+	}
+	switch {
+	case os.IsNotExist(err):
+		return replaceFile(path, content, mode, false)
+	case err != nil:
+		return err
+	}
+	return replaceFile(path, content, info.Mode().Perm(), true)
+}
+
+// resolveSymlink returns the file the symlink at path points to, which may not
+// exist yet.
+func resolveSymlink(path string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if !os.IsNotExist(err) {
+		return resolved, err
+	}
+	link, err := os.Readlink(path)
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(link) {
+		link = filepath.Join(filepath.Dir(path), link)
+	}
+	return link, nil
+}
+
+// replaceFile writes content to a new temporary file next to path, created
+// with mode minus the umask, and renames it to path. With exactMode the mode
+// is set regardless of the umask.
+func replaceFile(path string, content []byte, mode os.FileMode, exactMode bool) error {
+	f, err := createTempFile(path, mode)
+	if err != nil {
+		return err
+	}
+	trackTempFile(f.Name())
+	defer func() {
+		os.Remove(f.Name())
+		untrackTempFile(f.Name())
+	}()
+
+	_, err = f.Write(content)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if exactMode {
+		if err := os.Chmod(f.Name(), mode); err != nil {
+			return err
+		}
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// createTempFile creates a new file with mode next to path. Unlike
+// ioutil.TempFile it doesn't restrict the mode to 0600.
+func createTempFile(path string, mode os.FileMode) (*os.File, error) {
+	prefix := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".")
+	for i := 0; ; i++ {
+		name := prefix + strconv.FormatInt(time.Now().UnixNano()+int64(i), 36)
+		f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+		if os.IsExist(err) && i < 100 {
+			continue
+		}
+		return f, err
+	}
+}
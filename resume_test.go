@@ -0,0 +1,82 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPullManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp-resume")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, manifestName)
+	en, de := filepath.Join(dir, "en.yml"), filepath.Join(dir, "de.yml")
+	for _, file := range []string{en, de} {
+		if err := ioutil.WriteFile(file, []byte("content of "+file), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	manifest := loadManifest(path)
+	if manifest.Completed(en) {
+		t.Errorf("expected no file to be completed in a new manifest")
+	}
+	if err := manifest.Record(en); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest = loadManifest(path)
+	if !manifest.Completed(en) {
+		t.Errorf("expected the recorded file to be completed")
+	}
+	if manifest.Completed(de) {
+		t.Errorf("expected a file not recorded to be incomplete")
+	}
+
+	if err := ioutil.WriteFile(en, []byte("truncated"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if manifest.Completed(en) {
+		t.Errorf("expected a changed file to be incomplete")
+	}
+
+	if err := manifest.Remove(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the manifest to be removed, got %v", err)
+	}
+
+	var none *pullManifest
+	if none.Completed(en) || none.Record(en) != nil || none.Remove() != nil {
+		t.Errorf("expected a nil manifest to do nothing")
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp-resume")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "en.yml")
+	if err := ioutil.WriteFile(path, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeFileAtomic(path, []byte("new"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if content, _ := ioutil.ReadFile(path); string(content) != "new" {
+		t.Errorf("expected the file to be replaced, got %q", content)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("expected no temporary files to be left, got %d files", len(files))
+	}
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 
@@ -313,9 +312,33 @@ func router(cfg *phraseapp.Config) (*cli.Router, error) {
 
 	r.Register("push", &PushCommand{Config: cfg}, "Upload locales to your PhraseApp project.\n  You can provide parameters supported by the uploads#create endpoint http://docs.phraseapp.com/api/v2/uploads/#create\n  in your configuration (.phraseapp.yml) for each source.\n  See our configuration guide for more information http://docs.phraseapp.com/developers/cli/configuration/")
 
+	if cmd, err := newKeysExport(cfg); err != nil {
+		return nil, err
+	} else {
+		r.Register("keys/export", cmd, "Write all keys of the given project to a file as newline delimited JSON.")
+	}
+
+	r.Register("keys/import", newKeysImport(cfg), "Create keys from the rows of a CSV or JSON file.")
+
+	if cmd, err := newLocalesDownloadAll(cfg); err != nil {
+		return nil, err
+	} else {
+		r.Register("locales/download/all", cmd, "Download all locales of the given project into a directory, named by their locale code.")
+	}
+
+	r.Register("version/restore", newVersionRestore(cfg), "Set a translation back to the content of the given version.")
+
+	r.Register("translation/get", newTranslationGet(cfg), "Print the content of the translation of a key in a locale.")
+
+	r.Register("diff", &DiffCommand{Config: cfg}, "Show how the files of your push sources differ from the locales in your PhraseApp project.")
+
+	r.Register("gitignore", &GitignoreCommand{Config: cfg}, "Print the files written by pull as .gitignore patterns, or add them to .gitignore.")
+
 	r.Register("init", &WizardCommand{}, "Configure your PhraseApp client.")
 
-	r.RegisterFunc("info", infoCommand, "Info about version and revision of this client")
+	r.Register("info", &InfoCommand{}, "Info about version and revision of this client")
+
+	r.Register("selfupdate", &SelfUpdateCommand{}, "Update this client to the latest release.")
 
 	return r, nil
 }
@@ -353,7 +376,7 @@ func (cmd *AuthorizationCreate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type AuthorizationDelete struct {
@@ -376,6 +399,10 @@ func (cmd *AuthorizationDelete) Run() error {
 		return err
 	}
 
+	if err := confirmDestructive(client, "delete authorization "+cmd.ID); err != nil {
+		return err
+	}
+
 	err = client.AuthorizationDelete(cmd.ID)
 
 	if err != nil {
@@ -388,6 +415,8 @@ func (cmd *AuthorizationDelete) Run() error {
 type AuthorizationShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ID string `cli:"arg required"`
 }
 
@@ -399,6 +428,10 @@ func newAuthorizationShow(cfg *phraseapp.Config) *AuthorizationShow {
 }
 
 func (cmd *AuthorizationShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -411,7 +444,7 @@ func (cmd *AuthorizationShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type AuthorizationUpdate struct {
@@ -449,14 +482,15 @@ func (cmd *AuthorizationUpdate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type AuthorizationsList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page     int    `cli:"opt --page default=1"`
+	PerPage  int    `cli:"opt --per-page default=25"`
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 }
 
 func newAuthorizationsList(cfg *phraseapp.Config) *AuthorizationsList {
@@ -473,6 +507,10 @@ func newAuthorizationsList(cfg *phraseapp.Config) *AuthorizationsList {
 }
 
 func (cmd *AuthorizationsList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -485,7 +523,7 @@ func (cmd *AuthorizationsList) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type BlacklistedKeyCreate struct {
@@ -524,7 +562,7 @@ func (cmd *BlacklistedKeyCreate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type BlacklistedKeyDelete struct {
@@ -549,6 +587,10 @@ func (cmd *BlacklistedKeyDelete) Run() error {
 		return err
 	}
 
+	if err := confirmDestructive(client, "delete blacklisted key rule "+cmd.ID); err != nil {
+		return err
+	}
+
 	err = client.BlacklistedKeyDelete(cmd.ProjectID, cmd.ID)
 
 	if err != nil {
@@ -561,6 +603,8 @@ func (cmd *BlacklistedKeyDelete) Run() error {
 type BlacklistedKeyShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ProjectID string `cli:"arg required"`
 	ID        string `cli:"arg required"`
 }
@@ -574,6 +618,10 @@ func newBlacklistedKeyShow(cfg *phraseapp.Config) *BlacklistedKeyShow {
 }
 
 func (cmd *BlacklistedKeyShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -586,7 +634,7 @@ func (cmd *BlacklistedKeyShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type BlacklistedKeyUpdate struct {
@@ -626,14 +674,15 @@ func (cmd *BlacklistedKeyUpdate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type BlacklistedKeysList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page     int    `cli:"opt --page default=1"`
+	PerPage  int    `cli:"opt --per-page default=25"`
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 
 	ProjectID string `cli:"arg required"`
 }
@@ -653,6 +702,10 @@ func newBlacklistedKeysList(cfg *phraseapp.Config) *BlacklistedKeysList {
 }
 
 func (cmd *BlacklistedKeysList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -665,7 +718,7 @@ func (cmd *BlacklistedKeysList) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type CommentCreate struct {
@@ -705,7 +758,7 @@ func (cmd *CommentCreate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type CommentDelete struct {
@@ -731,6 +784,10 @@ func (cmd *CommentDelete) Run() error {
 		return err
 	}
 
+	if err := confirmDestructive(client, "delete comment "+cmd.ID); err != nil {
+		return err
+	}
+
 	err = client.CommentDelete(cmd.ProjectID, cmd.KeyID, cmd.ID)
 
 	if err != nil {
@@ -839,6 +896,8 @@ func (cmd *CommentMarkUnread) Run() error {
 type CommentShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ProjectID string `cli:"arg required"`
 	KeyID     string `cli:"arg required"`
 	ID        string `cli:"arg required"`
@@ -853,6 +912,10 @@ func newCommentShow(cfg *phraseapp.Config) *CommentShow {
 }
 
 func (cmd *CommentShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -865,7 +928,7 @@ func (cmd *CommentShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type CommentUpdate struct {
@@ -906,14 +969,15 @@ func (cmd *CommentUpdate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type CommentsList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page     int    `cli:"opt --page default=1"`
+	PerPage  int    `cli:"opt --per-page default=25"`
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 
 	ProjectID string `cli:"arg required"`
 	KeyID     string `cli:"arg required"`
@@ -934,6 +998,10 @@ func newCommentsList(cfg *phraseapp.Config) *CommentsList {
 }
 
 func (cmd *CommentsList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -946,14 +1014,15 @@ func (cmd *CommentsList) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type FormatsList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page     int    `cli:"opt --page default=1"`
+	PerPage  int    `cli:"opt --per-page default=25"`
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 }
 
 func newFormatsList(cfg *phraseapp.Config) *FormatsList {
@@ -970,6 +1039,10 @@ func newFormatsList(cfg *phraseapp.Config) *FormatsList {
 }
 
 func (cmd *FormatsList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -982,7 +1055,7 @@ func (cmd *FormatsList) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type KeyCreate struct {
@@ -1021,7 +1094,7 @@ func (cmd *KeyCreate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type KeyDelete struct {
@@ -1046,6 +1119,10 @@ func (cmd *KeyDelete) Run() error {
 		return err
 	}
 
+	if err := confirmDestructive(client, "delete key "+cmd.ID); err != nil {
+		return err
+	}
+
 	err = client.KeyDelete(cmd.ProjectID, cmd.ID)
 
 	if err != nil {
@@ -1058,6 +1135,8 @@ func (cmd *KeyDelete) Run() error {
 type KeyShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ProjectID string `cli:"arg required"`
 	ID        string `cli:"arg required"`
 }
@@ -1071,6 +1150,10 @@ func newKeyShow(cfg *phraseapp.Config) *KeyShow {
 }
 
 func (cmd *KeyShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -1083,7 +1166,7 @@ func (cmd *KeyShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type KeyUpdate struct {
@@ -1123,7 +1206,7 @@ func (cmd *KeyUpdate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type KeysDelete struct {
@@ -1156,13 +1239,21 @@ func (cmd *KeysDelete) Run() error {
 		return err
 	}
 
+	confirmed, err := confirmKeysDelete(client, cmd.ProjectID, params, "keys/delete")
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return printJSON(&phraseapp.AffectedResources{})
+	}
+
 	res, err := client.KeysDelete(cmd.ProjectID, params)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type KeysList struct {
@@ -1170,8 +1261,14 @@ type KeysList struct {
 
 	phraseapp.KeysListParams
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page        int    `cli:"opt --page default=1"`
+	PerPage     int    `cli:"opt --per-page default=25"`
+	FailOnEmpty bool   `cli:"opt --fail-on-empty desc='exit with an error if nothing was found'"`
+	KeysOnly    bool   `cli:"opt --keys-only desc='print only the key names, one per line'"`
+	Template    string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+	Unused      bool   `cli:"opt --unused desc='print the names of all keys without translation in any locale, or in the one of --locale-id'"`
+	Delete      bool   `cli:"opt --delete desc='delete the keys found with --unused, after confirmation'"`
+	Count       bool   `cli:"opt --count desc='print only the number of matching keys, ignoring --page and --per-page'"`
 
 	ProjectID string `cli:"arg required"`
 }
@@ -1197,6 +1294,10 @@ func newKeysList(cfg *phraseapp.Config) (*KeysList, error) {
 }
 
 func (cmd *KeysList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 	params := &cmd.KeysListParams
 
 	client, err := newClient(cmd.Config.Credentials)
@@ -1204,13 +1305,61 @@ func (cmd *KeysList) Run() error {
 		return err
 	}
 
+	if cmd.Delete && !cmd.Unused {
+		return &invalidError{fmt.Errorf("--delete requires --unused")}
+	}
+	if err := checkCountFlags(cmd.Count, cmd.KeysOnly, cmd.Template); err != nil {
+		return err
+	}
+	if cmd.Count && cmd.Unused {
+		return &invalidError{fmt.Errorf("--count can't be combined with --unused")}
+	}
+	if cmd.Unused {
+		keys, err := unusedKeys(client, cmd.ProjectID, params)
+		if err != nil {
+			return err
+		}
+		if tmpl == nil {
+			printKeyNames(os.Stdout, keys)
+		} else if err := printOutput(tmpl, &keys); err != nil {
+			return err
+		}
+		if cmd.Delete {
+			deleted, err := deleteKeys(client, cmd.ProjectID, keys, "keys/list --delete")
+			if err != nil {
+				return err
+			}
+			if deleted > 0 && !Quiet {
+				fmt.Fprintf(os.Stderr, "Deleted %d unused keys\n", deleted)
+			}
+			return nil
+		}
+		return checkEmpty(cmd.FailOnEmpty, len(keys))
+	}
+
+	if cmd.Count {
+		count, err := countKeys(client, func(client *phraseapp.Client, page, perPage int) ([]*phraseapp.TranslationKey, error) {
+			return client.KeysList(cmd.ProjectID, page, perPage, params)
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Println(count)
+		return checkEmpty(cmd.FailOnEmpty, int(count))
+	}
+
 	res, err := client.KeysList(cmd.ProjectID, cmd.Page, cmd.PerPage, params)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	if cmd.KeysOnly {
+		printKeyNames(os.Stdout, res)
+	} else if err := printOutput(tmpl, &res); err != nil {
+		return err
+	}
+	return checkEmpty(cmd.FailOnEmpty, len(res))
 }
 
 type KeysSearch struct {
@@ -1218,8 +1367,12 @@ type KeysSearch struct {
 
 	phraseapp.KeysSearchParams
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page        int    `cli:"opt --page default=1"`
+	PerPage     int    `cli:"opt --per-page default=25"`
+	FailOnEmpty bool   `cli:"opt --fail-on-empty desc='exit with an error if nothing was found'"`
+	KeysOnly    bool   `cli:"opt --keys-only desc='print only the key names, one per line'"`
+	Template    string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+	Count       bool   `cli:"opt --count desc='print only the number of matching keys, ignoring --page and --per-page'"`
 
 	ProjectID string `cli:"arg required"`
 }
@@ -1245,20 +1398,43 @@ func newKeysSearch(cfg *phraseapp.Config) (*KeysSearch, error) {
 }
 
 func (cmd *KeysSearch) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 	params := &cmd.KeysSearchParams
+	if err := checkCountFlags(cmd.Count, cmd.KeysOnly, cmd.Template); err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
 		return err
 	}
 
+	if cmd.Count {
+		count, err := countKeys(client, func(client *phraseapp.Client, page, perPage int) ([]*phraseapp.TranslationKey, error) {
+			return client.KeysSearch(cmd.ProjectID, page, perPage, params)
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Println(count)
+		return checkEmpty(cmd.FailOnEmpty, int(count))
+	}
+
 	res, err := client.KeysSearch(cmd.ProjectID, cmd.Page, cmd.PerPage, params)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	if cmd.KeysOnly {
+		printKeyNames(os.Stdout, res)
+	} else if err := printOutput(tmpl, &res); err != nil {
+		return err
+	}
+	return checkEmpty(cmd.FailOnEmpty, len(res))
 }
 
 type KeysTag struct {
@@ -1266,6 +1442,8 @@ type KeysTag struct {
 
 	phraseapp.KeysTagParams
 
+	FromFile string `cli:"opt --from-file desc='tag the keys named in the given file, one per line, instead of the ones matching --query'"`
+
 	ProjectID string `cli:"arg required"`
 }
 
@@ -1291,13 +1469,31 @@ func (cmd *KeysTag) Run() error {
 		return err
 	}
 
+	if cmd.FromFile != "" {
+		if params.Q != nil {
+			return &invalidError{fmt.Errorf("--from-file and --query exclude each other")}
+		}
+		tags := ""
+		if params.Tags != nil {
+			tags = *params.Tags
+		}
+		res, err := tagKeysFromFile(client, cmd.ProjectID, cmd.FromFile, tags, params.LocaleID, false)
+		if err != nil {
+			return err
+		}
+		if !Quiet {
+			fmt.Fprintln(os.Stderr, res.summary("Tagged"))
+		}
+		return printJSON(&phraseapp.AffectedResources{RecordsAffected: res.Affected})
+	}
+
 	res, err := client.KeysTag(cmd.ProjectID, params)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type KeysUntag struct {
@@ -1305,6 +1501,8 @@ type KeysUntag struct {
 
 	phraseapp.KeysUntagParams
 
+	FromFile string `cli:"opt --from-file desc='untag the keys named in the given file, one per line, instead of the ones matching --query'"`
+
 	ProjectID string `cli:"arg required"`
 }
 
@@ -1330,13 +1528,31 @@ func (cmd *KeysUntag) Run() error {
 		return err
 	}
 
+	if cmd.FromFile != "" {
+		if params.Q != nil {
+			return &invalidError{fmt.Errorf("--from-file and --query exclude each other")}
+		}
+		tags := ""
+		if params.Tags != nil {
+			tags = *params.Tags
+		}
+		res, err := tagKeysFromFile(client, cmd.ProjectID, cmd.FromFile, tags, params.LocaleID, true)
+		if err != nil {
+			return err
+		}
+		if !Quiet {
+			fmt.Fprintln(os.Stderr, res.summary("Untagged"))
+		}
+		return printJSON(&phraseapp.AffectedResources{RecordsAffected: res.Affected})
+	}
+
 	res, err := client.KeysUntag(cmd.ProjectID, params)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type LocaleCreate struct {
@@ -1344,6 +1560,10 @@ type LocaleCreate struct {
 
 	phraseapp.LocaleParams
 
+	FromFile     string `cli:"opt --from-file desc='upload the given file to the created locale, which is deleted again if uploading fails'"`
+	FileFormat   string `cli:"opt --file-format desc='format of --from-file (default file_format of the configuration or detected from the extension)'"`
+	PollInterval string `cli:"opt --poll-interval default=2s desc='time between checks of the upload state with --from-file'"`
+
 	ProjectID string `cli:"arg required"`
 }
 
@@ -1369,13 +1589,31 @@ func (cmd *LocaleCreate) Run() error {
 		return err
 	}
 
+	seed, err := newLocaleSeed(client, cmd.FromFile, cmd.FileFormat, cmd.Config.DefaultFileFormat, cmd.PollInterval)
+	if err != nil {
+		return err
+	}
+
 	res, err := client.LocaleCreate(cmd.ProjectID, params)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	if seed != nil {
+		if !Quiet {
+			fmt.Fprintf(os.Stderr, "Created locale %s (%s)\n", res.Name, res.ID)
+		}
+		upload, err := seed.upload(client, cmd.ProjectID, res)
+		if err != nil {
+			return err
+		}
+		if !Quiet {
+			fmt.Fprintln(os.Stderr, uploadSummary(upload))
+		}
+	}
+
+	return printJSON(&res)
 }
 
 type LocaleDelete struct {
@@ -1400,6 +1638,10 @@ func (cmd *LocaleDelete) Run() error {
 		return err
 	}
 
+	if err := confirmDestructive(client, "delete locale "+cmd.ID); err != nil {
+		return err
+	}
+
 	err = client.LocaleDelete(cmd.ProjectID, cmd.ID)
 
 	if err != nil {
@@ -1414,6 +1656,9 @@ type LocaleDownload struct {
 
 	phraseapp.LocaleDownloadParams
 
+	To   string `cli:"opt --to desc='write the locale to the given file instead of stdout, creating missing directories'"`
+	Mode string `cli:"opt --mode desc='file mode of the file written with --to, e.g. 0644 (default 0700 like pull)'"`
+
 	ProjectID string `cli:"arg required"`
 	ID        string `cli:"arg required"`
 }
@@ -1438,6 +1683,11 @@ func newLocaleDownload(cfg *phraseapp.Config) (*LocaleDownload, error) {
 func (cmd *LocaleDownload) Run() error {
 	params := &cmd.LocaleDownloadParams
 
+	mode, err := parseFileMode(cmd.Mode)
+	if err != nil {
+		return &invalidError{fmt.Errorf("invalid --mode: %s", err)}
+	}
+
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
 		return err
@@ -1449,6 +1699,27 @@ func (cmd *LocaleDownload) Run() error {
 		return err
 	}
 
+	if cmd.To != "" {
+		if err := writeLocaleFile(cmd.To, res, mode); err != nil {
+			return err
+		}
+		if !Quiet {
+			fmt.Printf("Downloaded locale %s to %s\n", cmd.ID, cmd.To)
+		}
+		return nil
+	}
+
+	if params.FileFormat != nil {
+		format, err := FindFormat(client, *params.FileFormat)
+		if err != nil {
+			return err
+		}
+		if IsBinaryFormat(format) {
+			_, err = os.Stdout.Write(res)
+			return err
+		}
+	}
+
 	fmt.Println(string(res))
 	return nil
 }
@@ -1456,6 +1727,8 @@ func (cmd *LocaleDownload) Run() error {
 type LocaleShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ProjectID string `cli:"arg required"`
 	ID        string `cli:"arg required"`
 }
@@ -1469,6 +1742,10 @@ func newLocaleShow(cfg *phraseapp.Config) *LocaleShow {
 }
 
 func (cmd *LocaleShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -1481,7 +1758,7 @@ func (cmd *LocaleShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type LocaleUpdate struct {
@@ -1521,14 +1798,17 @@ func (cmd *LocaleUpdate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type LocalesList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page         int    `cli:"opt --page default=1"`
+	PerPage      int    `cli:"opt --per-page default=25"`
+	All          bool   `cli:"opt --all desc='list the locales of all pages'"`
+	Completeness bool   `cli:"opt --completeness desc='print a table of the translated and verified share of keys per locale'"`
+	Template     string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 
 	ProjectID string `cli:"arg required"`
 }
@@ -1548,19 +1828,31 @@ func newLocalesList(cfg *phraseapp.Config) *LocalesList {
 }
 
 func (cmd *LocalesList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
 		return err
 	}
 
-	res, err := client.LocalesList(cmd.ProjectID, cmd.Page, cmd.PerPage)
+	res, err := listLocales(client, cmd.ProjectID, cmd.Page, cmd.PerPage, cmd.All)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	if cmd.Completeness {
+		details, err := localeCompleteness(client, cmd.ProjectID, res)
+		if err != nil {
+			return err
+		}
+		return printCompleteness(os.Stdout, details)
+	}
+
+	return printOutput(tmpl, &res)
 }
 
 type OrderConfirm struct {
@@ -1591,7 +1883,7 @@ func (cmd *OrderConfirm) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type OrderCreate struct {
@@ -1630,7 +1922,7 @@ func (cmd *OrderCreate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type OrderDelete struct {
@@ -1655,6 +1947,10 @@ func (cmd *OrderDelete) Run() error {
 		return err
 	}
 
+	if err := confirmDestructive(client, "cancel order "+cmd.ID); err != nil {
+		return err
+	}
+
 	err = client.OrderDelete(cmd.ProjectID, cmd.ID)
 
 	if err != nil {
@@ -1667,6 +1963,8 @@ func (cmd *OrderDelete) Run() error {
 type OrderShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ProjectID string `cli:"arg required"`
 	ID        string `cli:"arg required"`
 }
@@ -1680,6 +1978,10 @@ func newOrderShow(cfg *phraseapp.Config) *OrderShow {
 }
 
 func (cmd *OrderShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -1692,14 +1994,17 @@ func (cmd *OrderShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type OrdersList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page     int      `cli:"opt --page default=1"`
+	PerPage  int      `cli:"opt --per-page default=25"`
+	All      bool     `cli:"opt --all desc='list the orders of all pages'"`
+	Status   []string `cli:"opt --status desc='only orders in the given states, e.g. confirmed,in_progress,completed'"`
+	Template string   `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 
 	ProjectID string `cli:"arg required"`
 }
@@ -1719,19 +2024,30 @@ func newOrdersList(cfg *phraseapp.Config) *OrdersList {
 }
 
 func (cmd *OrdersList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
 		return err
 	}
 
-	res, err := client.OrdersList(cmd.ProjectID, cmd.Page, cmd.PerPage)
+	orders, err := listOrders(client, cmd.ProjectID, cmd.Page, cmd.PerPage, cmd.All)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	res := filterOrders(orders, cmd.Status)
+	if err := printOutput(tmpl, &res); err != nil {
+		return err
+	}
+	if !Quiet {
+		fmt.Fprintln(os.Stderr, ordersSummary(len(res), len(orders), cmd.Status))
+	}
+	return nil
 }
 
 type ProjectCreate struct {
@@ -1767,7 +2083,7 @@ func (cmd *ProjectCreate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type ProjectDelete struct {
@@ -1790,6 +2106,10 @@ func (cmd *ProjectDelete) Run() error {
 		return err
 	}
 
+	if err := confirmDestructive(client, "delete project "+cmd.ID); err != nil {
+		return err
+	}
+
 	err = client.ProjectDelete(cmd.ID)
 
 	if err != nil {
@@ -1802,6 +2122,8 @@ func (cmd *ProjectDelete) Run() error {
 type ProjectShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ID string `cli:"arg required"`
 }
 
@@ -1813,6 +2135,10 @@ func newProjectShow(cfg *phraseapp.Config) *ProjectShow {
 }
 
 func (cmd *ProjectShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -1825,7 +2151,7 @@ func (cmd *ProjectShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type ProjectUpdate struct {
@@ -1863,14 +2189,15 @@ func (cmd *ProjectUpdate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type ProjectsList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page     int    `cli:"opt --page default=1"`
+	PerPage  int    `cli:"opt --per-page default=25"`
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 }
 
 func newProjectsList(cfg *phraseapp.Config) *ProjectsList {
@@ -1887,6 +2214,10 @@ func newProjectsList(cfg *phraseapp.Config) *ProjectsList {
 }
 
 func (cmd *ProjectsList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -1899,7 +2230,7 @@ func (cmd *ProjectsList) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type ShowUser struct {
@@ -1926,7 +2257,7 @@ func (cmd *ShowUser) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type StyleguideCreate struct {
@@ -1965,7 +2296,7 @@ func (cmd *StyleguideCreate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type StyleguideDelete struct {
@@ -1990,6 +2321,10 @@ func (cmd *StyleguideDelete) Run() error {
 		return err
 	}
 
+	if err := confirmDestructive(client, "delete style guide "+cmd.ID); err != nil {
+		return err
+	}
+
 	err = client.StyleguideDelete(cmd.ProjectID, cmd.ID)
 
 	if err != nil {
@@ -2002,6 +2337,8 @@ func (cmd *StyleguideDelete) Run() error {
 type StyleguideShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ProjectID string `cli:"arg required"`
 	ID        string `cli:"arg required"`
 }
@@ -2015,6 +2352,10 @@ func newStyleguideShow(cfg *phraseapp.Config) *StyleguideShow {
 }
 
 func (cmd *StyleguideShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -2027,7 +2368,7 @@ func (cmd *StyleguideShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type StyleguideUpdate struct {
@@ -2067,14 +2408,15 @@ func (cmd *StyleguideUpdate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type StyleguidesList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page     int    `cli:"opt --page default=1"`
+	PerPage  int    `cli:"opt --per-page default=25"`
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 
 	ProjectID string `cli:"arg required"`
 }
@@ -2094,6 +2436,10 @@ func newStyleguidesList(cfg *phraseapp.Config) *StyleguidesList {
 }
 
 func (cmd *StyleguidesList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -2106,7 +2452,7 @@ func (cmd *StyleguidesList) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type TagCreate struct {
@@ -2145,7 +2491,7 @@ func (cmd *TagCreate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type TagDelete struct {
@@ -2170,6 +2516,10 @@ func (cmd *TagDelete) Run() error {
 		return err
 	}
 
+	if err := confirmDestructive(client, "delete tag "+cmd.Name); err != nil {
+		return err
+	}
+
 	err = client.TagDelete(cmd.ProjectID, cmd.Name)
 
 	if err != nil {
@@ -2182,6 +2532,8 @@ func (cmd *TagDelete) Run() error {
 type TagShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ProjectID string `cli:"arg required"`
 	Name      string `cli:"arg required"`
 }
@@ -2195,6 +2547,10 @@ func newTagShow(cfg *phraseapp.Config) *TagShow {
 }
 
 func (cmd *TagShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -2207,14 +2563,17 @@ func (cmd *TagShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type TagsList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page         int    `cli:"opt --page default=1"`
+	PerPage      int    `cli:"opt --per-page default=25"`
+	All          bool   `cli:"opt --all desc='list the tags of all pages'"`
+	WithProgress bool   `cli:"opt --with-progress desc='print a table of the number of keys and the translated share per tag'"`
+	Template     string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 
 	ProjectID string `cli:"arg required"`
 }
@@ -2234,19 +2593,31 @@ func newTagsList(cfg *phraseapp.Config) *TagsList {
 }
 
 func (cmd *TagsList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
 		return err
 	}
 
-	res, err := client.TagsList(cmd.ProjectID, cmd.Page, cmd.PerPage)
+	res, err := listTags(client, cmd.ProjectID, cmd.Page, cmd.PerPage, cmd.All)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	if cmd.WithProgress {
+		tags, err := tagProgress(client, cmd.ProjectID, res)
+		if err != nil {
+			return err
+		}
+		return printTagProgress(os.Stdout, tags)
+	}
+
+	return printOutput(tmpl, &res)
 }
 
 type TranslationCreate struct {
@@ -2254,6 +2625,8 @@ type TranslationCreate struct {
 
 	phraseapp.TranslationParams
 
+	IfMissing bool `cli:"opt --if-missing desc='only create the translation if the key has none in the locale yet, otherwise print the existing one'"`
+
 	ProjectID string `cli:"arg required"`
 }
 
@@ -2279,18 +2652,35 @@ func (cmd *TranslationCreate) Run() error {
 		return err
 	}
 
+	if cmd.IfMissing {
+		res, created, err := createTranslationIfMissing(client, cmd.ProjectID, params)
+		if err != nil {
+			return err
+		}
+		if !Quiet {
+			if created {
+				fmt.Fprintf(os.Stderr, "Created translation %s\n", res.ID)
+			} else {
+				fmt.Fprintf(os.Stderr, "Translation %s exists, skipped\n", res.ID)
+			}
+		}
+		return printJSON(&res)
+	}
+
 	res, err := client.TranslationCreate(cmd.ProjectID, params)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type TranslationShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ProjectID string `cli:"arg required"`
 	ID        string `cli:"arg required"`
 }
@@ -2304,6 +2694,10 @@ func newTranslationShow(cfg *phraseapp.Config) *TranslationShow {
 }
 
 func (cmd *TranslationShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -2316,7 +2710,7 @@ func (cmd *TranslationShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type TranslationUpdate struct {
@@ -2356,7 +2750,7 @@ func (cmd *TranslationUpdate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type TranslationsByKey struct {
@@ -2364,8 +2758,10 @@ type TranslationsByKey struct {
 
 	phraseapp.TranslationsByKeyParams
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page     int      `cli:"opt --page default=1"`
+	PerPage  int      `cli:"opt --per-page default=25"`
+	Tags     []string `cli:"opt --tag desc='only translations of keys with any of the given tags (comma separated)'"`
+	Template string   `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 
 	ProjectID string `cli:"arg required"`
 	KeyID     string `cli:"arg required"`
@@ -2392,8 +2788,18 @@ func newTranslationsByKey(cfg *phraseapp.Config) (*TranslationsByKey, error) {
 }
 
 func (cmd *TranslationsByKey) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 	params := &cmd.TranslationsByKeyParams
 
+	q, err := withTags(params.Q, cmd.Tags)
+	if err != nil {
+		return err
+	}
+	params.Q = q
+
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
 		return err
@@ -2405,7 +2811,7 @@ func (cmd *TranslationsByKey) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type TranslationsByLocale struct {
@@ -2413,8 +2819,14 @@ type TranslationsByLocale struct {
 
 	phraseapp.TranslationsByLocaleParams
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page      int      `cli:"opt --page default=1"`
+	PerPage   int      `cli:"opt --per-page default=25"`
+	Tags      []string `cli:"opt --tag desc='only translations of keys with any of the given tags (comma separated)'"`
+	Template  string   `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+	All       bool     `cli:"opt --all desc='list the translations of all pages'"`
+	CSV       bool     `cli:"opt --csv desc='print the key, locale, content and state of the translations as CSV instead of JSON'"`
+	KeysOnly  bool     `cli:"opt --keys-only desc='print only the key names of the translations, one per line'"`
+	EmptyOnly bool     `cli:"opt --empty-only desc='only translations with empty content, e.g. as to-do list for translators'"`
 
 	ProjectID string `cli:"arg required"`
 	LocaleID  string `cli:"arg required"`
@@ -2441,20 +2853,53 @@ func newTranslationsByLocale(cfg *phraseapp.Config) (*TranslationsByLocale, erro
 }
 
 func (cmd *TranslationsByLocale) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
+	outputs := 0
+	for _, set := range []bool{cmd.CSV, cmd.KeysOnly, tmpl != nil} {
+		if set {
+			outputs++
+		}
+	}
+	if outputs > 1 {
+		return &invalidError{fmt.Errorf("--csv, --keys-only and --template exclude each other")}
+	}
 	params := &cmd.TranslationsByLocaleParams
 
+	q, err := withTags(params.Q, cmd.Tags)
+	if err != nil {
+		return err
+	}
+	params.Q = q
+
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
 		return err
 	}
 
-	res, err := client.TranslationsByLocale(cmd.ProjectID, cmd.LocaleID, cmd.Page, cmd.PerPage, params)
+	list := func(page, perPage int) ([]*phraseapp.Translation, error) {
+		return client.TranslationsByLocale(cmd.ProjectID, cmd.LocaleID, page, perPage, params)
+	}
+	res, err := listTranslations(list, cmd.Page, cmd.PerPage, cmd.All)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	if cmd.EmptyOnly {
+		res = emptyTranslations(res)
+	}
+
+	switch {
+	case cmd.CSV:
+		return printTranslationsCSV(os.Stdout, res)
+	case cmd.KeysOnly:
+		printTranslationKeyNames(os.Stdout, res)
+		return nil
+	}
+	return printOutput(tmpl, &res)
 }
 
 type TranslationsExclude struct {
@@ -2487,13 +2932,17 @@ func (cmd *TranslationsExclude) Run() error {
 		return err
 	}
 
+	if err := confirmDestructive(client, "exclude all translations matching the query from locale downloads"); err != nil {
+		return err
+	}
+
 	res, err := client.TranslationsExclude(cmd.ProjectID, params)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type TranslationsInclude struct {
@@ -2532,7 +2981,7 @@ func (cmd *TranslationsInclude) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type TranslationsList struct {
@@ -2540,8 +2989,13 @@ type TranslationsList struct {
 
 	phraseapp.TranslationsListParams
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page        int      `cli:"opt --page default=1"`
+	PerPage     int      `cli:"opt --per-page default=25"`
+	FailOnEmpty bool     `cli:"opt --fail-on-empty desc='exit with an error if nothing was found'"`
+	Tags        []string `cli:"opt --tag desc='only translations of keys with any of the given tags (comma separated)'"`
+	Template    string   `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+	All         bool     `cli:"opt --all desc='list the translations of all pages'"`
+	CSV         bool     `cli:"opt --csv desc='print the key, locale, content and state of the translations as CSV instead of JSON'"`
 
 	ProjectID string `cli:"arg required"`
 }
@@ -2567,20 +3021,44 @@ func newTranslationsList(cfg *phraseapp.Config) (*TranslationsList, error) {
 }
 
 func (cmd *TranslationsList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
+	if cmd.CSV && tmpl != nil {
+		return &invalidError{fmt.Errorf("--csv and --template exclude each other")}
+	}
 	params := &cmd.TranslationsListParams
 
+	q, err := withTags(params.Q, cmd.Tags)
+	if err != nil {
+		return err
+	}
+	params.Q = q
+
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
 		return err
 	}
 
-	res, err := client.TranslationsList(cmd.ProjectID, cmd.Page, cmd.PerPage, params)
+	list := func(page, perPage int) ([]*phraseapp.Translation, error) {
+		return client.TranslationsList(cmd.ProjectID, page, perPage, params)
+	}
+	res, err := listTranslations(list, cmd.Page, cmd.PerPage, cmd.All)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	if cmd.CSV {
+		err = printTranslationsCSV(os.Stdout, res)
+	} else {
+		err = printOutput(tmpl, &res)
+	}
+	if err != nil {
+		return err
+	}
+	return checkEmpty(cmd.FailOnEmpty, len(res))
 }
 
 type TranslationsSearch struct {
@@ -2588,8 +3066,13 @@ type TranslationsSearch struct {
 
 	phraseapp.TranslationsSearchParams
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page        int      `cli:"opt --page default=1"`
+	PerPage     int      `cli:"opt --per-page default=25"`
+	FailOnEmpty bool     `cli:"opt --fail-on-empty desc='exit with an error if nothing was found'"`
+	Tags        []string `cli:"opt --tag desc='only translations of keys with any of the given tags (comma separated)'"`
+	Template    string   `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+	All         bool     `cli:"opt --all desc='list the translations of all pages'"`
+	CSV         bool     `cli:"opt --csv desc='print the key, locale, content and state of the translations as CSV instead of JSON'"`
 
 	ProjectID string `cli:"arg required"`
 }
@@ -2615,20 +3098,44 @@ func newTranslationsSearch(cfg *phraseapp.Config) (*TranslationsSearch, error) {
 }
 
 func (cmd *TranslationsSearch) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
+	if cmd.CSV && tmpl != nil {
+		return &invalidError{fmt.Errorf("--csv and --template exclude each other")}
+	}
 	params := &cmd.TranslationsSearchParams
 
+	q, err := withTags(params.Q, cmd.Tags)
+	if err != nil {
+		return err
+	}
+	params.Q = q
+
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
 		return err
 	}
 
-	res, err := client.TranslationsSearch(cmd.ProjectID, cmd.Page, cmd.PerPage, params)
+	list := func(page, perPage int) ([]*phraseapp.Translation, error) {
+		return client.TranslationsSearch(cmd.ProjectID, page, perPage, params)
+	}
+	res, err := listTranslations(list, cmd.Page, cmd.PerPage, cmd.All)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	if cmd.CSV {
+		err = printTranslationsCSV(os.Stdout, res)
+	} else {
+		err = printOutput(tmpl, &res)
+	}
+	if err != nil {
+		return err
+	}
+	return checkEmpty(cmd.FailOnEmpty, len(res))
 }
 
 type TranslationsUnverify struct {
@@ -2667,7 +3174,7 @@ func (cmd *TranslationsUnverify) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type TranslationsVerify struct {
@@ -2706,7 +3213,7 @@ func (cmd *TranslationsVerify) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type UploadCreate struct {
@@ -2714,6 +3221,11 @@ type UploadCreate struct {
 
 	phraseapp.UploadParams
 
+	Wait         bool   `cli:"opt --wait desc='wait until the upload has been processed, fails if processing fails'"`
+	PollInterval string `cli:"opt --poll-interval default=2s desc='time between checks of the upload state with --wait'"`
+	Cleanup      bool   `cli:"opt --cleanup desc='after processing, delete keys not contained in the uploaded file (implies --wait)'"`
+	CleanupTags  string `cli:"opt --cleanup-tags desc='comma separated tags to limit --cleanup to keys with any of them'"`
+
 	ProjectID string `cli:"arg required"`
 }
 
@@ -2742,18 +3254,75 @@ func (cmd *UploadCreate) Run() error {
 		return err
 	}
 
+	if params.File != nil && *params.File == stdinFile {
+		if StdinYAML {
+			return &invalidError{fmt.Errorf("--stdin-yaml can't be used when uploading a file from stdin")}
+		}
+		format := ""
+		if params.FileFormat != nil {
+			format = *params.FileFormat
+		}
+		dir, path, err := bufferUpload(os.Stdin, format)
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+		params.File = &path
+	}
+
+	interval, err := parsePollInterval(cmd.PollInterval)
+	if err != nil {
+		return err
+	}
+
+	if cmd.CleanupTags != "" && !cmd.Cleanup {
+		return &invalidError{fmt.Errorf("--cleanup-tags requires --cleanup")}
+	}
+	if cmd.Cleanup {
+		if _, err := cleanupQuery("", splitTags(cmd.CleanupTags)); err != nil {
+			return err
+		}
+	}
+
 	res, err := client.UploadCreate(cmd.ProjectID, params)
 
 	if err != nil {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	if !cmd.Wait && !cmd.Cleanup {
+		return printJSON(&res)
+	}
+
+	res, waitErr := waitForUpload(client, cmd.ProjectID, res, interval)
+	if err := printJSON(&res); err != nil {
+		return err
+	}
+	if waitErr != nil {
+		return waitErr
+	}
+	if !Quiet {
+		fmt.Fprintln(os.Stderr, uploadSummary(res))
+	}
+	if !cmd.Cleanup {
+		return nil
+	}
+
+	deleted, err := cleanupUpload(client, cmd.ProjectID, res, cmd.CleanupTags)
+	if err != nil {
+		return err
+	}
+	if deleted > 0 && !Quiet {
+		fmt.Fprintf(os.Stderr, "Deleted %d keys not contained in %s\n", deleted, res.Filename)
+	}
+	return nil
 }
 
 type UploadShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ProjectID string `cli:"arg required"`
 	ID        string `cli:"arg required"`
 }
@@ -2767,6 +3336,10 @@ func newUploadShow(cfg *phraseapp.Config) *UploadShow {
 }
 
 func (cmd *UploadShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -2779,14 +3352,15 @@ func (cmd *UploadShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type UploadsList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page     int    `cli:"opt --page default=1"`
+	PerPage  int    `cli:"opt --per-page default=25"`
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 
 	ProjectID string `cli:"arg required"`
 }
@@ -2806,6 +3380,10 @@ func newUploadsList(cfg *phraseapp.Config) *UploadsList {
 }
 
 func (cmd *UploadsList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -2818,12 +3396,14 @@ func (cmd *UploadsList) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type VersionShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ProjectID     string `cli:"arg required"`
 	TranslationID string `cli:"arg required"`
 	ID            string `cli:"arg required"`
@@ -2838,6 +3418,10 @@ func newVersionShow(cfg *phraseapp.Config) *VersionShow {
 }
 
 func (cmd *VersionShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -2850,14 +3434,15 @@ func (cmd *VersionShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type VersionsList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page     int    `cli:"opt --page default=1"`
+	PerPage  int    `cli:"opt --per-page default=25"`
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 
 	ProjectID     string `cli:"arg required"`
 	TranslationID string `cli:"arg required"`
@@ -2878,6 +3463,10 @@ func newVersionsList(cfg *phraseapp.Config) *VersionsList {
 }
 
 func (cmd *VersionsList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -2890,7 +3479,7 @@ func (cmd *VersionsList) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type WebhookCreate struct {
@@ -2929,7 +3518,7 @@ func (cmd *WebhookCreate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type WebhookDelete struct {
@@ -2954,6 +3543,10 @@ func (cmd *WebhookDelete) Run() error {
 		return err
 	}
 
+	if err := confirmDestructive(client, "delete webhook "+cmd.ID); err != nil {
+		return err
+	}
+
 	err = client.WebhookDelete(cmd.ProjectID, cmd.ID)
 
 	if err != nil {
@@ -2966,6 +3559,8 @@ func (cmd *WebhookDelete) Run() error {
 type WebhookShow struct {
 	*phraseapp.Config
 
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
+
 	ProjectID string `cli:"arg required"`
 	ID        string `cli:"arg required"`
 }
@@ -2979,6 +3574,10 @@ func newWebhookShow(cfg *phraseapp.Config) *WebhookShow {
 }
 
 func (cmd *WebhookShow) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -2991,7 +3590,7 @@ func (cmd *WebhookShow) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
 
 type WebhookTest struct {
@@ -3062,14 +3661,15 @@ func (cmd *WebhookUpdate) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printJSON(&res)
 }
 
 type WebhooksList struct {
 	*phraseapp.Config
 
-	Page    int `cli:"opt --page default=1"`
-	PerPage int `cli:"opt --per-page default=25"`
+	Page     int    `cli:"opt --page default=1"`
+	PerPage  int    `cli:"opt --per-page default=25"`
+	Template string `cli:"opt --template desc='print every item with the given Go template, e.g. {{.ID}} {{.Name}}, instead of JSON'"`
 
 	ProjectID string `cli:"arg required"`
 }
@@ -3089,6 +3689,10 @@ func newWebhooksList(cfg *phraseapp.Config) *WebhooksList {
 }
 
 func (cmd *WebhooksList) Run() error {
+	tmpl, err := parseOutputTemplate(cmd.Template)
+	if err != nil {
+		return err
+	}
 
 	client, err := newClient(cmd.Config.Credentials)
 	if err != nil {
@@ -3101,5 +3705,5 @@ func (cmd *WebhooksList) Run() error {
 		return err
 	}
 
-	return json.NewEncoder(os.Stdout).Encode(&res)
+	return printOutput(tmpl, &res)
 }
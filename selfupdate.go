@@ -0,0 +1,190 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/dynport/dgtk/version"
+)
+
+// SelfUpdateCommand replaces the running binary with the latest release.
+type SelfUpdateCommand struct {
+	URL   string `cli:"opt --url desc='releases URL to update from, e.g. a mirror (also available as PHRASEAPP_UPDATE_URL)'"`
+	Check bool   `cli:"opt --check desc='only report whether a newer version is available'"`
+}
+
+func (cmd *SelfUpdateCommand) Run() error {
+	base := releasesURL
+	if cmd.URL != "" {
+		base = strings.TrimSuffix(cmd.URL, "/")
+	}
+
+	latest, err := latestVersionAt(base + "/latest")
+	if err != nil {
+		return err
+	}
+
+	newer, err := isNewerVersion(PHRASEAPP_CLIENT_VERSION, latest)
+	if err != nil {
+		return err
+	}
+	if !newer {
+		fmt.Printf("PhraseApp client %s is up to date\n", PHRASEAPP_CLIENT_VERSION)
+		return nil
+	}
+	if cmd.Check {
+		fmt.Printf("PhraseApp client %s is available, you're running %s. Run phraseapp selfupdate to update\n", latest, PHRASEAPP_CLIENT_VERSION)
+		return nil
+	}
+
+	name, err := releaseBinaryName(runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return err
+	}
+	binary, err := downloadRelease(base, latest, name)
+	if err != nil {
+		return err
+	}
+
+	path, err := executablePath()
+	if err != nil {
+		return err
+	}
+	if err := replaceExecutable(path, binary); err != nil {
+		return fmt.Errorf("failed to replace %s: %s", path, err)
+	}
+
+	fmt.Printf("Updated PhraseApp client from %s to %s\n", PHRASEAPP_CLIENT_VERSION, latest)
+	return nil
+}
+
+// isNewerVersion reports whether latest is newer than current. Development
+// builds can't be compared and aren't updated.
+func isNewerVersion(current, latest string) (bool, error) {
+	lower := strings.ToLower(current)
+	if strings.Contains(lower, "test") || strings.Contains(lower, "dev") {
+		return false, fmt.Errorf("development version %s can't be updated, install a release from %s", current, cliLandingPageUrl)
+	}
+
+	currentVersion, err := version.NewFromString(current)
+	if err != nil {
+		return false, err
+	}
+	latestVersion, err := version.NewFromString(latest)
+	if err != nil {
+		return false, err
+	}
+	return currentVersion.Less(latestVersion), nil
+}
+
+// releaseBinaryName returns the name of the binary released for the platform,
+// see jenkins.sh.
+func releaseBinaryName(goos, goarch string) (string, error) {
+	switch {
+	case goos == "linux" && (goarch == "amd64" || goarch == "386"):
+		return "phraseapp_linux_" + goarch, nil
+	case goos == "darwin" && goarch == "amd64":
+		return "phraseapp_macosx_amd64", nil
+	case goos == "windows" && goarch == "amd64":
+		return "phraseapp_windows_amd64.exe", nil
+	}
+	return "", fmt.Errorf("no release is built for %s/%s", goos, goarch)
+}
+
+// downloadRelease downloads the binary name of the release and verifies it
+// against the SHA256 checksum published next to it.
+func downloadRelease(base, release, name string) ([]byte, error) {
+	url := fmt.Sprintf("%s/download/%s/%s", base, release, name)
+	if !Quiet {
+		fmt.Println("Downloading", url)
+	}
+
+	binary, err := fetchURL(url)
+	if err != nil {
+		return nil, err
+	}
+	checksum, err := fetchURL(url + ".sha256")
+	if err != nil {
+		return nil, fmt.Errorf("failed to download the checksum: %s", err)
+	}
+
+	fields := strings.Fields(string(checksum))
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("empty checksum for %s", url)
+	}
+	sum := sha256.Sum256(binary)
+	if !strings.EqualFold(fields[0], hex.EncodeToString(sum[:])) {
+		return nil, fmt.Errorf("checksum mismatch for %s, the download might be corrupted", url)
+	}
+	return binary, nil
+}
+
+func fetchURL(url string) ([]byte, error) {
+	client := &http.Client{Transport: &contextTransport{next: sharedTransport(), ctx: commandContext}}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error requesting %s, expected status %d was %d", url, http.StatusOK, resp.StatusCode)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// executablePath returns the absolute path of the running binary.
+func executablePath() (string, error) {
+	path := os.Args[0]
+	if !strings.ContainsRune(path, os.PathSeparator) && !strings.ContainsRune(path, '/') {
+		found, err := exec.LookPath(path)
+		if err != nil {
+			return "", err
+		}
+		path = found
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(path)
+}
+
+// replaceExecutable atomically replaces the binary at path with content.
+// Windows doesn't allow to replace a running binary, it's moved aside first.
+func replaceExecutable(path string, content []byte) error {
+	if len(content) == 0 {
+		return fmt.Errorf("empty binary")
+	}
+	write := func() error { return writeFileAtomic(path, content, 0755) }
+	if runtime.GOOS == "windows" {
+		return moveAsideAndWrite(path, write)
+	}
+	return write()
+}
+
+// moveAsideAndWrite renames the file at path to path.old before write creates
+// the new one. If write fails, the old file is moved back, so the binary isn't
+// lost.
+func moveAsideAndWrite(path string, write func() error) error {
+	old := path + ".old"
+	os.Remove(old)
+	if err := os.Rename(path, old); err != nil {
+		return err
+	}
+	if err := write(); err != nil {
+		if restoreErr := os.Rename(old, path); restoreErr != nil {
+			return fmt.Errorf("%s, restoring the previous binary from %s failed: %s", err, old, restoreErr)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReleaseBinaryName(t *testing.T) {
+	tt := []struct {
+		goos, goarch, name string
+	}{
+		{"linux", "amd64", "phraseapp_linux_amd64"},
+		{"linux", "386", "phraseapp_linux_386"},
+		{"darwin", "amd64", "phraseapp_macosx_amd64"},
+		{"windows", "amd64", "phraseapp_windows_amd64.exe"},
+	}
+	for _, tc := range tt {
+		name, err := releaseBinaryName(tc.goos, tc.goarch)
+		if err != nil {
+			t.Errorf("%s/%s: %s", tc.goos, tc.goarch, err)
+		} else if name != tc.name {
+			t.Errorf("%s/%s: expected %q, got %q", tc.goos, tc.goarch, tc.name, name)
+		}
+	}
+
+	if _, err := releaseBinaryName("linux", "arm"); err == nil {
+		t.Errorf("expected an error for a platform without release")
+	}
+}
+
+func TestIsNewerVersion(t *testing.T) {
+	tt := []struct {
+		current, latest string
+		newer           bool
+	}{
+		{"1.1.10", "1.1.11", true},
+		{"1.1.11", "1.1.11", false},
+		{"1.2.0", "1.1.11", false},
+	}
+	for _, tc := range tt {
+		newer, err := isNewerVersion(tc.current, tc.latest)
+		if err != nil {
+			t.Errorf("%s < %s: %s", tc.current, tc.latest, err)
+		} else if newer != tc.newer {
+			t.Errorf("%s < %s: expected %t, got %t", tc.current, tc.latest, tc.newer, newer)
+		}
+	}
+
+	if _, err := isNewerVersion("DEV", "1.1.11"); err == nil {
+		t.Errorf("expected development versions not to be updated")
+	}
+}
+
+func TestDownloadRelease(t *testing.T) {
+	binary := []byte("new binary")
+	sum := sha256.Sum256(binary)
+	checksum := hex.EncodeToString(sum[:]) + "  phraseapp_linux_amd64\n"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/download/1.2.0/phraseapp_linux_amd64", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(binary)
+	})
+	mux.HandleFunc("/download/1.2.0/phraseapp_linux_amd64.sha256", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(checksum))
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	oldQuiet := Quiet
+	Quiet = true
+	defer func() { Quiet = oldQuiet }()
+
+	content, err := downloadRelease(s.URL, "1.2.0", "phraseapp_linux_amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != string(binary) {
+		t.Errorf("expected %q, got %q", binary, content)
+	}
+
+	checksum = "0000" + checksum[4:]
+	if _, err := downloadRelease(s.URL, "1.2.0", "phraseapp_linux_amd64"); err == nil {
+		t.Errorf("expected a checksum mismatch")
+	}
+
+	if _, err := downloadRelease(s.URL, "1.2.0", "phraseapp_macosx_amd64"); err == nil {
+		t.Errorf("expected an error for a missing binary")
+	}
+}
+
+func TestReplaceExecutable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp-selfupdate-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "phraseapp")
+	if err := ioutil.WriteFile(path, []byte("old binary"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := replaceExecutable(path, []byte("new binary")); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "new binary" {
+		t.Errorf("expected the binary to be replaced, got %q", content)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm()&0100 == 0 {
+		t.Errorf("expected the binary to be executable, mode is %s", fi.Mode())
+	}
+}
+
+func TestMoveAsideAndWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp-selfupdate-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "phraseapp.exe")
+	if err := ioutil.WriteFile(path, []byte("old binary"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	err = moveAsideAndWrite(path, func() error { return fmt.Errorf("disk full") })
+	if err == nil || err.Error() != "disk full" {
+		t.Errorf("expected the error of the failed write, got %v", err)
+	}
+	if content, err := ioutil.ReadFile(path); err != nil || string(content) != "old binary" {
+		t.Errorf("expected the old binary to be restored, got %q (%v)", content, err)
+	}
+	if _, err := os.Stat(path + ".old"); !os.IsNotExist(err) {
+		t.Errorf("expected no .old file after restoring, got %v", err)
+	}
+
+	err = moveAsideAndWrite(path, func() error { return writeFileAtomic(path, []byte("new binary"), 0755) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content, err := ioutil.ReadFile(path); err != nil || string(content) != "new binary" {
+		t.Errorf("expected the binary to be replaced, got %q (%v)", content, err)
+	}
+	if content, err := ioutil.ReadFile(path + ".old"); err != nil || string(content) != "old binary" {
+		t.Errorf("expected the old binary to be moved aside, got %q (%v)", content, err)
+	}
+}
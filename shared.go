@@ -15,11 +15,18 @@ var Debug bool
 type LocaleFiles []*LocaleFile
 type LocaleFile struct {
 	Path, Name, ID, Code, Tag, FileFormat string
-	ExistsRemote                          bool
+	ExistsRemote, ExistsLocal             bool
+
+	// UploadTags are the tags the keys of an uploaded file were tagged with.
+	UploadTags string
 }
 
 var placeholderRegexp = regexp.MustCompile("<(locale_name|tag|locale_code)>")
 
+// Older versions of the init wizard wrote their configuration to this file,
+// which is never picked up when reading the configuration.
+const legacyConfigName = ".phraseapp.yaml"
+
 const docsBaseUrl = "https://phraseapp.com/docs"
 const docsConfigUrl = docsBaseUrl + "/developers/cli/configuration"
 
@@ -86,6 +93,10 @@ func (localeFile *LocaleFile) Message() string {
 }
 
 func sharedMessage(method string, localeFile *LocaleFile) {
+	if Quiet {
+		return
+	}
+
 	local := localeFile.RelPath()
 
 	if method == "pull" {
@@ -98,12 +109,26 @@ func sharedMessage(method string, localeFile *LocaleFile) {
 		ct.Foreground(ct.Green, true)
 		fmt.Print(local, "\n")
 		ct.ResetColor()
+	} else if method == "unchanged" {
+		remote := localeFile.Message()
+		fmt.Print("Unchanged ")
+		ct.Foreground(ct.Green, true)
+		fmt.Print(remote)
+		ct.ResetColor()
+		fmt.Print(" at ")
+		ct.Foreground(ct.Green, true)
+		fmt.Print(local, "\n")
+		ct.ResetColor()
 	} else {
 		fmt.Print("Uploaded ")
 		ct.Foreground(ct.Green, true)
 		fmt.Print(local)
 		ct.ResetColor()
-		fmt.Println(" successfully.")
+		if localeFile.UploadTags != "" {
+			fmt.Printf(" successfully (tagged %s).\n", strings.Replace(localeFile.UploadTags, ",", ", ", -1))
+		} else {
+			fmt.Println(" successfully.")
+		}
 	}
 }
 
@@ -125,6 +150,48 @@ func RemoteLocales(client *phraseapp.Client, projectId string) ([]*phraseapp.Loc
 	return result, nil
 }
 
+// Extensions of formats whose content is binary and must not be altered.
+var binaryExtensions = []string{"xlsx"}
+
+// IsBinaryFormat reports whether downloads in the given format must be written
+// byte by byte. Appending a newline would corrupt binary and UTF-16 content.
+func IsBinaryFormat(format *phraseapp.Format) bool {
+	if format == nil {
+		return false
+	}
+	return Contains(binaryExtensions, format.Extension) || strings.HasPrefix(strings.ToUpper(format.DefaultEncoding), "UTF-16")
+}
+
+// FindFormat returns the format with the given API name or nil if there is none.
+func FindFormat(client *phraseapp.Client, name string) (*phraseapp.Format, error) {
+	formats, err := client.FormatsList(1, 100)
+	if err != nil {
+		return nil, err
+	}
+	for _, format := range formats {
+		if format.ApiName == name {
+			return format, nil
+		}
+	}
+	return nil, nil
+}
+
+// LocaleCache keeps the remote locales per project, so they are only fetched
+// once per command run.
+type LocaleCache map[string][]*phraseapp.Locale
+
+func (cache LocaleCache) RemoteLocales(client *phraseapp.Client, projectId string) ([]*phraseapp.Locale, error) {
+	if locales, found := cache[projectId]; found {
+		return locales, nil
+	}
+	locales, err := RemoteLocales(client, projectId)
+	if err != nil {
+		return nil, err
+	}
+	cache[projectId] = locales
+	return locales, nil
+}
+
 func Contains(seq []string, str string) bool {
 	for _, elem := range seq {
 		if str == elem {
@@ -140,3 +207,20 @@ func Exists(absPath string) error {
 	}
 	return nil
 }
+
+// configHint returns a hint to rename the configuration file if one written by
+// an older version of the init wizard is found in the current directory.
+func configHint() string {
+	if Exists(legacyConfigName) != nil || Exists(configName) == nil {
+		return ""
+	}
+	return fmt.Sprintf("\nFound %q in the current directory, please rename it to %q", legacyConfigName, configName)
+}
+
+// warnMissingBranch warns if the file pattern uses the <branch> placeholder
+// while no branch is set, as the placeholder is then replaced with nothing.
+func warnMissingBranch(pattern string) {
+	if strings.Contains(pattern, "<branch>") && Branch == "" {
+		warnf("%s uses <branch> but no branch is set, the placeholder is left empty", pattern)
+	}
+}
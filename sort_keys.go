@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/gopkg.in/yaml.v2"
+)
+
+// sortableFormats maps the file formats whose key order doesn't matter to the
+// function sorting the keys of their files. Other formats are left untouched.
+var sortableFormats = map[string]func([]byte) ([]byte, error){
+	"simple_json":       sortJSONKeys,
+	"nested_json":       sortJSONKeys,
+	"react_simple_json": sortJSONKeys,
+	"react_nested_json": sortJSONKeys,
+	"i18next":           sortJSONKeys,
+	"angular_translate": sortJSONKeys,
+	"yml":               sortYAMLKeys,
+	"yml_symfony":       sortYAMLKeys,
+	"yml_symfony2":      sortYAMLKeys,
+}
+
+// sortKeys re-serializes the content of a file in format with sorted keys,
+// so the files of repeated pulls only differ where translations changed.
+// Content of other formats or failing to parse is returned unchanged.
+func sortKeys(content []byte, format string) []byte {
+	sortFile, found := sortableFormats[format]
+	if !found {
+		return content
+	}
+	sorted, err := sortFile(content)
+	if err != nil {
+		warnf("failed to sort the keys of the %s file, writing it as downloaded: %s", format, err)
+		return content
+	}
+	return sorted
+}
+
+// sortJSONKeys indents with two spaces like PhraseApp does. Encoding maps
+// sorts their keys, numbers are kept as they are.
+func sortJSONKeys(content []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return keepTrailingNewline(content, buf.Bytes()), nil
+}
+
+// sortYAMLKeys relies on the YAML encoder sorting the keys of maps. A leading
+// document marker is kept, comments and the quoting style of values aren't.
+func sortYAMLKeys(content []byte) ([]byte, error) {
+	var v interface{}
+	if err := yaml.Unmarshal(content, &v); err != nil {
+		return nil, err
+	}
+	sorted, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(content, []byte("---")) {
+		sorted = append([]byte("---\n"), sorted...)
+	}
+	return keepTrailingNewline(content, sorted), nil
+}
+
+// keepTrailingNewline ends sorted with a newline only if content did.
+func keepTrailingNewline(content, sorted []byte) []byte {
+	if !bytes.HasSuffix(content, []byte("\n")) {
+		return bytes.TrimRight(sorted, "\n")
+	}
+	return sorted
+}
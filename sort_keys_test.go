@@ -0,0 +1,40 @@
+package main
+
+import "testing"
+
+func TestSortKeys(t *testing.T) {
+	tt := []struct {
+		format, in, expected string
+	}{
+		{
+			"nested_json",
+			`{"b": {"y": "<b>1</b>", "x": 2.50}, "a": [3, 1]}` + "\n",
+			"{\n  \"a\": [\n    3,\n    1\n  ],\n  \"b\": {\n    \"x\": 2.50,\n    \"y\": \"<b>1</b>\"\n  }\n}\n",
+		},
+		{
+			"simple_json",
+			`{"b": "1", "a": "2"}`,
+			"{\n  \"a\": \"2\",\n  \"b\": \"1\"\n}",
+		},
+		{
+			"yml",
+			"---\nen:\n  b: one\n  a:\n    d: two\n    c: three\n",
+			"---\nen:\n  a:\n    c: three\n    d: two\n  b: one\n",
+		},
+		{
+			"properties",
+			"b=1\na=2\n",
+			"b=1\na=2\n",
+		},
+		{
+			"simple_json",
+			`{"b": "1", "a": `,
+			`{"b": "1", "a": `,
+		},
+	}
+	for _, tc := range tt {
+		if got := string(sortKeys([]byte(tc.in), tc.format)); got != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tc.format, tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/gopkg.in/yaml.v2"
+)
+
+// StdinYAML reads settings overriding the configuration from stdin
+// (--stdin-yaml).
+var StdinYAML bool
+
+// overrides are the settings read with --stdin-yaml, nil without.
+var overrides *configOverrides
+
+// configOverrides are settings given at runtime taking precedence over the
+// configuration: defaults of commands and params of all pull targets and push
+// sources. Options given on the command line still take precedence over them.
+type configOverrides struct {
+	Defaults   map[string]map[string]interface{}
+	PullParams map[string]interface{}
+	PushParams map[string]interface{}
+}
+
+func (o *configOverrides) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	defaults := map[string]interface{}{}
+	pull := map[string]interface{}{}
+	push := map[string]interface{}{}
+	err := phraseapp.ParseYAMLToMap(unmarshal, map[string]interface{}{
+		"defaults": &defaults,
+		"pull":     &pull,
+		"push":     &push,
+	})
+	if err != nil {
+		return err
+	}
+
+	o.Defaults = map[string]map[string]interface{}{}
+	for path, raw := range defaults {
+		if o.Defaults[path], err = phraseapp.ValidateIsRawMap("defaults."+path, raw); err != nil {
+			return err
+		}
+	}
+	if o.PullParams, err = overrideParams("pull", pull); err != nil {
+		return err
+	}
+	o.PushParams, err = overrideParams("push", push)
+	return err
+}
+
+// overrideParams returns the params of the pull or push section, the only key
+// they may contain.
+func overrideParams(section string, m map[string]interface{}) (map[string]interface{}, error) {
+	for key := range m {
+		if key != "params" {
+			return nil, fmt.Errorf("%s.%s can't be given with --stdin-yaml, only %s.params", section, key, section)
+		}
+	}
+	if raw, found := m["params"]; found {
+		return phraseapp.ValidateIsRawMap(section+".params", raw)
+	}
+	return nil, nil
+}
+
+// readOverrides parses the settings of --stdin-yaml from r and merges the
+// defaults of commands into cfg. Params of targets and sources are applied by
+// TargetsFromConfig and SourcesFromConfig.
+func readOverrides(r io.Reader, cfg *phraseapp.Config) (*configOverrides, error) {
+	if f, ok := r.(*os.File); ok && isTerminal(f) {
+		return nil, fmt.Errorf("--stdin-yaml expects YAML piped to stdin")
+	}
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &configOverrides{}
+	if err := yaml.Unmarshal(content, o); err != nil {
+		return nil, fmt.Errorf("invalid YAML given with --stdin-yaml: %s", err)
+	}
+
+	if cfg.Defaults == nil {
+		cfg.Defaults = map[string]map[string]interface{}{}
+	}
+	for path, values := range o.Defaults {
+		if cfg.Defaults[path] == nil {
+			cfg.Defaults[path] = map[string]interface{}{}
+		}
+		for key, value := range values {
+			cfg.Defaults[path][key] = value
+		}
+	}
+	return o, nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadOverrides(t *testing.T) {
+	defer func() { overrides = nil }()
+
+	cfg, err := ParseConfig([]byte(`phraseapp:
+  access_token: some_token
+  project_id: project-id
+  defaults:
+    keys/list:
+      q: "tags:old"
+      sort: name
+  pull:
+    targets:
+    - file: ./<locale_code>.yml
+      params:
+        tag: old
+        locale_id: de
+  push:
+    sources:
+    - file: ./<locale_code>.yml
+      params:
+        update_translations: false
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	overrides, err = readOverrides(strings.NewReader(`defaults:
+  keys/list:
+    q: "tags:release-2"
+  translations/list:
+    sort: updated_at
+pull:
+  params:
+    tag: release-2
+push:
+  params:
+    update_translations: true
+`), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if defaults := cfg.Defaults["keys/list"]; defaults["q"] != "tags:release-2" || defaults["sort"] != "name" {
+		t.Errorf("expected the defaults of stdin to be merged over the configured ones, got %v", defaults)
+	}
+	if defaults := cfg.Defaults["translations/list"]; defaults["sort"] != "updated_at" {
+		t.Errorf("expected the defaults of stdin to be added, got %v", defaults)
+	}
+
+	targets, err := TargetsFromConfig(&PullCommand{Config: cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params := targets[0].Params; params.Tag == nil || *params.Tag != "release-2" || params.LocaleID != "de" {
+		t.Errorf("expected the target params of stdin to be merged over the configured ones, got %+v", params)
+	}
+
+	sources, err := SourcesFromConfig(&PushCommand{Config: cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params := sources[0].Params; params.UpdateTranslations == nil || !*params.UpdateTranslations {
+		t.Errorf("expected the source params of stdin to be applied, got %+v", params)
+	}
+}
+
+func TestReadOverridesInvalid(t *testing.T) {
+	for _, content := range []string{
+		"access_token: secret\n",
+		"pull:\n  targets: []\n",
+		"defaults: [keys/list]\n",
+		"pull: [",
+	} {
+		cfg, err := ParseConfig(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := readOverrides(strings.NewReader(content), cfg); err == nil {
+			t.Errorf("expected an error for %q", content)
+		}
+	}
+}
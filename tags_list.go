@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// listTags returns the tags of the given page, or of all pages if all is set.
+func listTags(client *phraseapp.Client, projectID string, page, perPage int, all bool) ([]*phraseapp.Tag, error) {
+	if !all {
+		return client.TagsList(projectID, page, perPage)
+	}
+
+	tags := []*phraseapp.Tag{}
+	for page := 1; ; page++ {
+		res, err := client.TagsList(projectID, page, maxPerPage)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, res...)
+		if len(res) < maxPerPage {
+			return tags, nil
+		}
+	}
+}
+
+// tagProgress fetches the statistics of every tag, which the list of tags
+// doesn't include.
+func tagProgress(client *phraseapp.Client, projectID string, tags []*phraseapp.Tag) ([]*phraseapp.TagWithStats, error) {
+	details := []*phraseapp.TagWithStats{}
+	for _, tag := range tags {
+		res, err := client.TagShow(projectID, tag.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the statistics of tag %s: %s", tag.Name, err)
+		}
+		details = append(details, res)
+	}
+	return details, nil
+}
+
+// printTagProgress prints a table of the number of keys per tag and the share
+// of their translations completed over all locales.
+func printTagProgress(w io.Writer, tags []*phraseapp.TagWithStats) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TAG\tKEYS\tTRANSLATED")
+	for _, tag := range tags {
+		var completed, total int64
+		for _, item := range tag.Statistics {
+			completed += item.Statistics.TranslationsCompletedCount
+			total += item.Statistics.KeysTotalCount
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", tag.Name, tag.KeysCount, percentage(completed, total))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestListAllTags(t *testing.T) {
+	total := maxPerPage + 3
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		first := 0
+		if r.URL.Query().Get("page") == "2" {
+			first = maxPerPage
+		}
+		tags := []map[string]string{}
+		for i := first; i < total && i < first+maxPerPage; i++ {
+			tags = append(tags, map[string]string{"name": fmt.Sprintf("tag-%d", i)})
+		}
+		json.NewEncoder(w).Encode(tags)
+	}))
+	defer s.Close()
+
+	tags, err := listTags(newTestClient(s.URL), "project-id", 1, 25, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != total {
+		t.Errorf("expected %d tags, got %d", total, len(tags))
+	}
+}
+
+func TestTagProgress(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/projects/project-id/tags/checkout":
+			w.Write([]byte(`{"name": "checkout", "keys_count": 4, "statistics": [
+				{"locale": {"code": "en"}, "statistics": {"keys_total_count": 4, "translations_completed_count": 4}},
+				{"locale": {"code": "de"}, "statistics": {"keys_total_count": 4, "translations_completed_count": 1}}
+			]}`))
+		case "/v2/projects/project-id/tags/empty":
+			w.Write([]byte(`{"name": "empty", "keys_count": 0, "statistics": []}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer s.Close()
+
+	tags := []*phraseapp.Tag{{Name: "checkout"}, {Name: "empty"}}
+	details, err := tagProgress(newTestClient(s.URL), "project-id", tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := printTagProgress(buf, details); err != nil {
+		t.Fatal(err)
+	}
+	expected := strings.Join([]string{
+		"TAG       KEYS  TRANSLATED",
+		"checkout  4     62%",
+		"empty     0     100%",
+		"",
+	}, "\n")
+	if buf.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, buf.String())
+	}
+
+	if _, err := tagProgress(newTestClient(s.URL), "project-id", []*phraseapp.Tag{{Name: "unknown"}}); err == nil {
+		t.Errorf("expected an error for an unknown tag")
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// createTranslationIfMissing creates the translation of params unless the key
+// already has one in the locale (and plural suffix). Returns the translation
+// and whether it was created, the existing one is returned otherwise.
+func createTranslationIfMissing(client *phraseapp.Client, projectID string, params *phraseapp.TranslationParams) (*phraseapp.TranslationDetails, bool, error) {
+	if params.KeyID == nil || *params.KeyID == "" || params.LocaleID == nil || *params.LocaleID == "" {
+		return nil, false, &invalidError{fmt.Errorf("--if-missing requires --key-id and --locale-id")}
+	}
+
+	existing, err := findTranslation(client, projectID, *params.KeyID, *params.LocaleID, stringValue(params.PluralSuffix))
+	if err != nil {
+		return nil, false, err
+	}
+	if existing != nil {
+		return &phraseapp.TranslationDetails{Translation: *existing}, false, nil
+	}
+
+	created, err := client.TranslationCreate(projectID, params)
+	if err != nil {
+		return nil, false, err
+	}
+	return created, true, nil
+}
+
+// findTranslation returns the translation of the key in the locale with the
+// given ID, name or code and plural suffix, nil if there is none.
+func findTranslation(client *phraseapp.Client, projectID, keyID, localeValue, pluralSuffix string) (*phraseapp.Translation, error) {
+	for page := 1; ; page++ {
+		translations, err := client.TranslationsByKey(projectID, keyID, page, maxPerPage, &phraseapp.TranslationsByKeyParams{})
+		if err != nil {
+			return nil, err
+		}
+		for _, translation := range translations {
+			if translation.PluralSuffix == pluralSuffix && matchesLocale(translation.Locale, localeValue) {
+				return translation, nil
+			}
+		}
+		if len(translations) < maxPerPage {
+			return nil, nil
+		}
+	}
+}
+
+func matchesLocale(locale *phraseapp.LocalePreview, value string) bool {
+	return locale != nil && (locale.ID == value || locale.Name == value || strings.EqualFold(locale.Code, value))
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
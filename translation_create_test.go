@@ -0,0 +1,55 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestCreateTranslationIfMissing(t *testing.T) {
+	creates := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/projects/project-id/keys/key-id/translations", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `[{"id": "en-translation", "content": "Hello", "locale": {"id": "en-id", "code": "en"}}]`)
+	})
+	mux.HandleFunc("/v2/projects/project-id/translations", func(w http.ResponseWriter, r *http.Request) {
+		creates++
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id": "de-translation", "content": "Hallo", "locale": {"id": "de-id", "code": "de"}}`)
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+	client := newTestClient(s.URL)
+
+	keyID, content := "key-id", "Hallo"
+	for _, tc := range []struct {
+		locale   string
+		created  bool
+		expected string
+	}{
+		{"en-id", false, "en-translation"},
+		{"EN", false, "en-translation"},
+		{"de-id", true, "de-translation"},
+	} {
+		locale := tc.locale
+		res, created, err := createTranslationIfMissing(client, "project-id", &phraseapp.TranslationParams{KeyID: &keyID, LocaleID: &locale, Content: &content})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created != tc.created || res.ID != tc.expected {
+			t.Errorf("%s: expected translation %s (created %t), got %s (created %t)", tc.locale, tc.expected, tc.created, res.ID, created)
+		}
+	}
+	if creates != 1 {
+		t.Errorf("expected a single translation to be created, got %d", creates)
+	}
+
+	if _, _, err := createTranslationIfMissing(client, "project-id", &phraseapp.TranslationParams{KeyID: &keyID}); err == nil {
+		t.Errorf("expected an error without locale")
+	} else if _, ok := err.(*invalidError); !ok {
+		t.Errorf("expected an invalid error, got %T", err)
+	}
+}
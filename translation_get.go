@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// TranslationGetCommand prints the content of the translation of a key in a
+// locale, without any JSON around it.
+type TranslationGetCommand struct {
+	*phraseapp.Config
+
+	ProjectID string `cli:"arg required"`
+	Locale    string `cli:"arg required"`
+	Key       string `cli:"arg required"`
+}
+
+func newTranslationGet(cfg *phraseapp.Config) *TranslationGetCommand {
+	cmd := &TranslationGetCommand{Config: cfg}
+	cmd.ProjectID = cfg.DefaultProjectID
+	return cmd
+}
+
+func (cmd *TranslationGetCommand) Run() error {
+	client, err := newClient(cmd.Config.Credentials)
+	if err != nil {
+		return err
+	}
+
+	contents, err := translationContents(client, cmd.ProjectID, cmd.Locale, cmd.Key)
+	if err != nil {
+		return err
+	}
+	for _, content := range contents {
+		fmt.Println(content)
+	}
+	return nil
+}
+
+// translationContents returns the contents of the translations of the key
+// with the given name in the locale with the given ID, name or code. Plural
+// keys have a translation for every plural form.
+func translationContents(client *phraseapp.Client, projectID, localeValue, keyName string) ([]string, error) {
+	locales, err := RemoteLocales(client, projectID)
+	if err != nil {
+		return nil, err
+	}
+	locale, err := findLocale(locales, localeValue)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := findKeyID(client, projectID, keyName)
+	if err != nil {
+		return nil, err
+	}
+	if keyID == "" {
+		return nil, fmt.Errorf("no key named %q in project %s", keyName, projectID)
+	}
+
+	translations, err := client.TranslationsByKey(projectID, keyID, 1, maxPerPage, &phraseapp.TranslationsByKeyParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	contents := []string{}
+	for _, translation := range translations {
+		if translation.Locale != nil && translation.Locale.ID == locale.ID {
+			contents = append(contents, translation.Content)
+		}
+	}
+	if len(contents) == 0 {
+		return nil, fmt.Errorf("key %q has no translation in locale %s", keyName, locale.Name)
+	}
+	return contents, nil
+}
+
+// findLocale returns the locale with the given ID, name or code.
+func findLocale(locales []*phraseapp.Locale, value string) (*phraseapp.Locale, error) {
+	matches := []*phraseapp.Locale{}
+	for _, locale := range locales {
+		if locale.ID == value {
+			return locale, nil
+		}
+		if locale.Name == value || strings.EqualFold(locale.Code, value) {
+			matches = append(matches, locale)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no locale with ID, name or code %q", value)
+	case 1:
+		return matches[0], nil
+	}
+	ids := []string{}
+	for _, locale := range matches {
+		ids = append(ids, locale.ID)
+	}
+	return nil, fmt.Errorf("locale %q is ambiguous, it matches the locales %s. Please use the locale ID", value, strings.Join(ids, ", "))
+}
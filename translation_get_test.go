@@ -0,0 +1,59 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestTranslationContents(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/projects/project-id/locales", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `[{"id": "en-id", "name": "English", "code": "en"}, {"id": "de-id", "name": "German", "code": "de"}]`)
+	})
+	mux.HandleFunc("/v2/projects/project-id/keys/search", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `[{"id": "other-id", "name": "home.title.long"}, {"id": "key-id", "name": "home.title"}]`)
+	})
+	mux.HandleFunc("/v2/projects/project-id/keys/key-id/translations", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `[{"content": "Hello", "locale": {"id": "en-id"}}, {"content": "Hallo", "locale": {"id": "de-id"}}]`)
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	client := newTestClient(s.URL)
+	contents, err := translationContents(client, "project-id", "DE", "home.title")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(contents, "|") != "Hallo" {
+		t.Errorf("expected the German content, got %v", contents)
+	}
+
+	if _, err := translationContents(client, "project-id", "fr", "home.title"); err == nil || !strings.Contains(err.Error(), "no locale") {
+		t.Errorf("expected an error for an unknown locale, got %v", err)
+	}
+	if _, err := translationContents(client, "project-id", "en", "home"); err == nil || !strings.Contains(err.Error(), "no key named") {
+		t.Errorf("expected an error for an unknown key, got %v", err)
+	}
+}
+
+func TestFindLocale(t *testing.T) {
+	locales := []*phraseapp.Locale{
+		{ID: "en-id", Name: "English", Code: "en"},
+		{ID: "en-gb-id", Name: "en", Code: "en-GB"},
+	}
+
+	if locale, err := findLocale(locales, "English"); err != nil || locale.ID != "en-id" {
+		t.Errorf("expected to find the locale by name, got %v, %v", locale, err)
+	}
+	if locale, err := findLocale(locales, "en-gb-id"); err != nil || locale.ID != "en-gb-id" {
+		t.Errorf("expected to find the locale by ID, got %v, %v", locale, err)
+	}
+	if _, err := findLocale(locales, "en"); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected an ambiguous match, got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// listTranslations returns the translations of the given page, or of all
+// pages if all is set. list requests a single page, so the same pagination
+// serves translations/list and translations/search.
+func listTranslations(list func(page, perPage int) ([]*phraseapp.Translation, error), page, perPage int, all bool) ([]*phraseapp.Translation, error) {
+	if !all {
+		return list(page, perPage)
+	}
+
+	translations := []*phraseapp.Translation{}
+	for page := 1; ; page++ {
+		res, err := list(page, maxPerPage)
+		if err != nil {
+			return nil, err
+		}
+		translations = append(translations, res...)
+		if len(res) < maxPerPage {
+			return translations, nil
+		}
+	}
+}
+
+// printTranslationsCSV writes the translations as CSV with the columns key,
+// locale, content and state (verified or unverified), e.g. to hand them
+// over for review in a spreadsheet.
+func printTranslationsCSV(w io.Writer, translations []*phraseapp.Translation) error {
+	out := csv.NewWriter(w)
+	out.Write([]string{"key", "locale", "content", "state"})
+	for _, translation := range translations {
+		key, locale := "", ""
+		if translation.Key != nil {
+			key = translation.Key.Name
+		}
+		if translation.Locale != nil {
+			locale = translation.Locale.Code
+		}
+		state := "verified"
+		if translation.Unverified {
+			state = "unverified"
+		}
+		out.Write([]string{key, locale, translation.Content, state})
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// emptyTranslations returns the translations without content. The API returns
+// missing content as null, so it can't be told apart from an empty string.
+func emptyTranslations(translations []*phraseapp.Translation) []*phraseapp.Translation {
+	empty := []*phraseapp.Translation{}
+	for _, translation := range translations {
+		if translation.Content == "" {
+			empty = append(empty, translation)
+		}
+	}
+	return empty
+}
+
+// printTranslationKeyNames writes the key name of every translation as a line
+// to w.
+func printTranslationKeyNames(w io.Writer, translations []*phraseapp.Translation) {
+	for _, translation := range translations {
+		if translation.Key != nil {
+			fmt.Fprintln(w, translation.Key.Name)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestListTranslationsAll(t *testing.T) {
+	pages := []int{}
+	list := func(page, perPage int) ([]*phraseapp.Translation, error) {
+		pages = append(pages, page)
+		if perPage != maxPerPage {
+			t.Errorf("expected pages of %d translations, got %d", maxPerPage, perPage)
+		}
+		count := maxPerPage
+		if page == 2 {
+			count = 1
+		}
+		return make([]*phraseapp.Translation, count), nil
+	}
+
+	res, err := listTranslations(list, 1, 25, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != maxPerPage+1 || len(pages) != 2 {
+		t.Errorf("expected %d translations from 2 pages, got %d from %v", maxPerPage+1, len(res), pages)
+	}
+}
+
+func TestPrintTranslationsCSV(t *testing.T) {
+	translations := []*phraseapp.Translation{
+		{Key: &phraseapp.KeyPreview{Name: "home.title"}, Locale: &phraseapp.LocalePreview{Code: "de"}, Content: "Willkommen, \"Gast\""},
+		{Key: &phraseapp.KeyPreview{Name: "home.body"}, Locale: &phraseapp.LocalePreview{Code: "de"}, Content: "Zeile 1\nZeile 2", Unverified: true},
+	}
+
+	out := &bytes.Buffer{}
+	if err := printTranslationsCSV(out, translations); err != nil {
+		t.Fatal(err)
+	}
+	expected := "key,locale,content,state\n" +
+		"home.title,de,\"Willkommen, \"\"Gast\"\"\",verified\n" +
+		"home.body,de,\"Zeile 1\nZeile 2\",unverified\n"
+	if out.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, out)
+	}
+}
+
+func TestEmptyTranslations(t *testing.T) {
+	translations := []*phraseapp.Translation{
+		{Key: &phraseapp.KeyPreview{Name: "home.title"}, Content: "Willkommen"},
+		{Key: &phraseapp.KeyPreview{Name: "home.body"}, Content: ""},
+		{Key: &phraseapp.KeyPreview{Name: "home.footer"}, Content: "Fußzeile", Unverified: true},
+		{Key: &phraseapp.KeyPreview{Name: "home.empty"}},
+	}
+
+	out := &bytes.Buffer{}
+	printTranslationKeyNames(out, emptyTranslations(translations))
+	if out.String() != "home.body\nhome.empty\n" {
+		t.Errorf("expected the keys of the empty translations, got %q", out)
+	}
+}
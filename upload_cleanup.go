@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// cleanupQuery returns the query for the keys not contained in the upload,
+// limited to keys with any of tags if given.
+func cleanupQuery(uploadID string, tags []string) (*string, error) {
+	q := "unmentioned_in_upload:" + uploadID
+	query, err := withTags(&q, tags)
+	if err != nil {
+		return nil, &invalidError{fmt.Errorf("--cleanup-tags: %s", err)}
+	}
+	return query, nil
+}
+
+// cleanupUpload deletes the keys of the project not contained in the processed
+// upload, limited to keys with any of the comma separated tags. The keys are
+// counted and the delete confirmed first. Returns the number of deleted keys.
+func cleanupUpload(client *phraseapp.Client, projectID string, upload *phraseapp.Upload, tags string) (int64, error) {
+	q, err := cleanupQuery(upload.ID, splitTags(tags))
+	if err != nil {
+		return 0, err
+	}
+	params := &phraseapp.KeysDeleteParams{Q: q}
+
+	confirmed, err := confirmKeysDelete(client, projectID, params, "upload/create --cleanup")
+	if err != nil || !confirmed {
+		return 0, err
+	}
+
+	res, err := client.KeysDelete(projectID, params)
+	if err != nil {
+		return 0, err
+	}
+	return res.RecordsAffected, nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestCleanupQuery(t *testing.T) {
+	q, err := cleanupQuery("upload-id", nil)
+	if err != nil || *q != "unmentioned_in_upload:upload-id" {
+		t.Errorf("unexpected query %v, error %v", q, err)
+	}
+
+	q, err = cleanupQuery("upload-id", []string{"web", "app"})
+	if err != nil || *q != "unmentioned_in_upload:upload-id tags:web,app" {
+		t.Errorf("unexpected query %v, error %v", q, err)
+	}
+
+	if _, err := cleanupQuery("upload-id", []string{"web app"}); err == nil {
+		t.Error("expected an error for an invalid tag")
+	} else if _, ok := err.(*invalidError); !ok {
+		t.Errorf("expected an invalid usage error, got %T", err)
+	}
+}
+
+func TestCleanupUpload(t *testing.T) {
+	defer func(yes bool) { AssumeYes = yes }(AssumeYes)
+	AssumeYes = true
+
+	deleteQuery := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/authorizations"):
+			io.WriteString(w, `[]`)
+		case strings.HasSuffix(r.URL.Path, "/keys/search"):
+			io.WriteString(w, `[{"id":"1"},{"id":"2"}]`)
+		case r.Method == "DELETE" && strings.HasSuffix(r.URL.Path, "/keys"):
+			var params struct {
+				Q string `json:"q"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+				t.Error(err)
+			}
+			deleteQuery = params.Q
+			io.WriteString(w, `{"records_affected":2}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	upload := &phraseapp.Upload{ID: "upload-id", Filename: "en.yml", State: "success"}
+	deleted, err := cleanupUpload(newTestClient(srv.URL), "project-id", upload, "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 2 {
+		t.Errorf("expected 2 deleted keys, got %d", deleted)
+	}
+	if deleteQuery != "unmentioned_in_upload:upload-id tags:web" {
+		t.Errorf("unexpected delete query %q", deleteQuery)
+	}
+}
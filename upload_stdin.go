@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Uploads with this file read the content from stdin.
+const stdinFile = "-"
+
+// bufferUpload writes the content of r to a file in a new temporary directory,
+// as uploads are sent from a file. The caller must remove the directory.
+func bufferUpload(r io.Reader, format string) (dir, path string, err error) {
+	if format == "" {
+		return "", "", fmt.Errorf("reading the file from stdin requires --file-format, it can't be derived from a file extension")
+	}
+
+	dir, err = ioutil.TempDir("", "phraseapp-upload")
+	if err != nil {
+		return "", "", err
+	}
+
+	path = filepath.Join(dir, "stdin."+format)
+	f, err := os.Create(path)
+	if err == nil {
+		_, err = io.Copy(f, r)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		os.RemoveAll(dir)
+		return "", "", err
+	}
+	return dir, path, nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBufferUpload(t *testing.T) {
+	dir, path, err := bufferUpload(strings.NewReader("en:\n  hello: Hello\n"), "yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if filepath.Base(path) != "stdin.yml" {
+		t.Errorf("expected the file to be named after the format, got %s", path)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "en:\n  hello: Hello\n" {
+		t.Errorf("expected stdin to be buffered, got %q", content)
+	}
+}
+
+func TestBufferUploadRequiresFormat(t *testing.T) {
+	if _, _, err := bufferUpload(strings.NewReader("content"), ""); err == nil {
+		t.Errorf("expected an error without a file format")
+	}
+}
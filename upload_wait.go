@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// defaultPollInterval is the time between checks of an upload's state if no
+// --poll-interval is given.
+const defaultPollInterval = 2 * time.Second
+
+// parsePollInterval parses the value of the --poll-interval option.
+func parsePollInterval(value string) (time.Duration, error) {
+	if value == "" {
+		return defaultPollInterval, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid value %q for --poll-interval, expected a positive duration like 2s", value)
+	}
+	return d, nil
+}
+
+// waitForUpload polls the state of the upload until it has been processed.
+// Uploads ending in the error state result in an error, returned along with
+// the upload.
+func waitForUpload(client *phraseapp.Client, projectID string, upload *phraseapp.Upload, interval time.Duration) (*phraseapp.Upload, error) {
+	for {
+		switch upload.State {
+		case "success":
+			return upload, nil
+		case "error":
+			return upload, fmt.Errorf("processing upload %s (%s) failed", upload.ID, upload.Filename)
+		}
+
+		time.Sleep(interval)
+		res, err := client.UploadShow(projectID, upload.ID)
+		if err != nil {
+			return upload, err
+		}
+		upload = res
+	}
+}
+
+// uploadSummary describes the changes made by a processed upload.
+func uploadSummary(upload *phraseapp.Upload) string {
+	s := upload.Summary
+	return fmt.Sprintf(
+		"%s: %d keys created, %d translations created, %d translations updated, %d locales created, %d tags created",
+		upload.Filename, s.TranslationKeysCreated, s.TranslationsCreated, s.TranslationsUpdated, s.LocalesCreated, s.TagsCreated,
+	)
+}
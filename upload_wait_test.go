@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestWaitForUpload(t *testing.T) {
+	for _, final := range []string{"success", "error"} {
+		states := []string{"processing", final}
+		requests := 0
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasSuffix(r.URL.Path, "/uploads/upload-id") {
+				t.Errorf("unexpected request to %s", r.URL.Path)
+			}
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			fmt.Fprintf(w, `{"id":"upload-id","filename":"en.yml","state":%q,"summary":{"translation_keys_created":3}}`, states[requests])
+			requests++
+		}))
+
+		// With the transports of newClient, so no poll is answered from cache.
+		client, err := newClient(&phraseapp.Credentials{Host: s.URL, Token: "some_token"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		upload := &phraseapp.Upload{ID: "upload-id", State: "enqueued"}
+		res, err := waitForUpload(client, "project-id", upload, time.Millisecond)
+		s.Close()
+
+		if requests != 2 {
+			t.Errorf("%s: expected 2 polls, got %d", final, requests)
+		}
+		if res.State != final {
+			t.Errorf("%s: expected the final upload, got state %q", final, res.State)
+		}
+		if final == "error" && err == nil {
+			t.Errorf("expected an error for a failed upload")
+		}
+		if final == "success" {
+			if err != nil {
+				t.Errorf("didn't expect an error, got %s", err)
+			}
+			if summary := uploadSummary(res); !strings.HasPrefix(summary, "en.yml: 3 keys created") {
+				t.Errorf("unexpected summary %q", summary)
+			}
+		}
+	}
+}
+
+func TestParsePollInterval(t *testing.T) {
+	if d, err := parsePollInterval(""); err != nil || d != defaultPollInterval {
+		t.Errorf("expected the default interval, got %s (%v)", d, err)
+	}
+	if d, err := parsePollInterval("500ms"); err != nil || d != 500*time.Millisecond {
+		t.Errorf("expected 500ms, got %s (%v)", d, err)
+	}
+	for _, value := range []string{"soon", "0s", "-1s"} {
+		if _, err := parsePollInterval(value); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+}
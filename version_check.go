@@ -13,13 +13,47 @@ import (
 
 const cliLandingPageUrl = "https://phraseapp.com/en/cli"
 
-var releaseURL = "https://github.com/phrase/phraseapp-client/releases/latest"
+// releasesURL is where releases are published. PHRASEAPP_UPDATE_URL
+// overrides it, e.g. for a mirror inside a company network.
+var releasesURL = "https://github.com/phrase/phraseapp-client/releases"
+
+// releaseURL redirects to the page of the latest release.
+var releaseURL = releasesURL + "/latest"
+
+func init() {
+	if url := os.Getenv("PHRASEAPP_UPDATE_URL"); url != "" {
+		releasesURL = strings.TrimSuffix(url, "/")
+		releaseURL = releasesURL + "/latest"
+	}
+}
 
 var PHRASEAPP_VERSION_TMP_FILE = "/tmp/.phraseapp.version"
 
-func ValidateVersion() {
-	if err := validateVersionWithErr(); err != nil {
-		fmt.Fprintf(os.Stderr, err.Error()+"\n\n")
+// checkVersionInBackground checks for a newer version while the command runs.
+// Failing to look up the latest version is only logged, as it doesn't
+// concern the command.
+func checkVersionInBackground() <-chan error {
+	c := make(chan error, 1)
+	go func() {
+		if _, err := readCurrentVersion(); err != nil {
+			logger.Debugf("failed to check for a newer version: %s", err)
+			c <- nil
+			return
+		}
+		c <- validateVersionWithErr()
+	}()
+	return c
+}
+
+// printVersionNotice prints the result of checkVersionInBackground if the
+// check finished, the command never waits for it.
+func printVersionNotice(c <-chan error) {
+	select {
+	case err := <-c:
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "\n%s\n", err)
+		}
+	default:
 	}
 }
 
@@ -37,12 +71,17 @@ func validateVersionWithErr() error {
 		return err
 	}
 	if clientVersion.Less(currentVersion) {
-		return fmt.Errorf("Please consider updating the PhraseApp CLI client (%s < %s)\nSee %s", PHRASEAPP_CLIENT_VERSION, currentVersion, cliLandingPageUrl)
+		return fmt.Errorf("Please consider updating the PhraseApp CLI client (%s < %s) with phraseapp selfupdate\nSee %s", PHRASEAPP_CLIENT_VERSION, currentVersion, cliLandingPageUrl)
 	}
 	return nil
 }
 
 func getLatestReleaseVersion() (string, error) {
+	return latestVersionAt(releaseURL)
+}
+
+// latestVersionAt returns the version releaseURL redirects to.
+func latestVersionAt(releaseURL string) (string, error) {
 	req, err := http.NewRequest("HEAD", releaseURL, nil)
 	if err != nil {
 		return "", err
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"github.com/phrase/phraseapp-client/Godeps/_workspace/src/github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// VersionRestoreCommand sets a translation back to the content of one of its
+// versions.
+type VersionRestoreCommand struct {
+	*phraseapp.Config
+
+	ProjectID     string `cli:"arg required"`
+	TranslationID string `cli:"arg required"`
+	ID            string `cli:"arg required"`
+}
+
+func newVersionRestore(cfg *phraseapp.Config) *VersionRestoreCommand {
+	cmd := &VersionRestoreCommand{Config: cfg}
+	cmd.ProjectID = cfg.DefaultProjectID
+	return cmd
+}
+
+func (cmd *VersionRestoreCommand) Run() error {
+	client, err := newClient(cmd.Config.Credentials)
+	if err != nil {
+		return err
+	}
+
+	res, err := restoreVersion(client, cmd.ProjectID, cmd.TranslationID, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(&res)
+}
+
+// restoreVersion updates the translation with the content of the version and
+// returns the updated translation.
+func restoreVersion(client *phraseapp.Client, projectID, translationID, id string) (*phraseapp.TranslationDetails, error) {
+	version, err := client.VersionShow(projectID, translationID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &phraseapp.TranslationUpdateParams{Content: &version.Content}
+	if version.PluralSuffix != "" {
+		params.PluralSuffix = &version.PluralSuffix
+	}
+	return client.TranslationUpdate(projectID, translationID, params)
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRestoreVersion(t *testing.T) {
+	var update map[string]string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/projects/project-id/translations/translation-id/versions/version-id", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"id": "version-id", "content": "Hello again"}`)
+	})
+	mux.HandleFunc("/v2/projects/project-id/translations/translation-id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" {
+			t.Errorf("expected the translation to be updated, got %s", r.Method)
+		}
+		json.NewDecoder(r.Body).Decode(&update)
+		io.WriteString(w, `{"id": "translation-id", "content": "Hello again"}`)
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	res, err := restoreVersion(newTestClient(s.URL), "project-id", "translation-id", "version-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if update["content"] != "Hello again" {
+		t.Errorf("expected the version content to be sent, got %v", update)
+	}
+	if res.Content != "Hello again" {
+		t.Errorf("expected the restored translation to be returned, got %q", res.Content)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Strict turns warnings into errors, so CI jobs fail instead of passing with
+// warnings nobody reads.
+var Strict bool
+
+// warnings counts the warnings reported with warnf.
+var warnings = struct {
+	sync.Mutex
+	count int
+}{}
+
+// warnf logs a warning about the configuration or the data a command works
+// on, like a pattern matching no files. With --strict it returns an error,
+// which callers able to stop return right away. Warnings of callers that
+// carry on fail the command once it finished, see checkStrict.
+func warnf(format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	logger.Warnf("%s", msg)
+
+	warnings.Lock()
+	warnings.count++
+	warnings.Unlock()
+
+	if !Strict {
+		return nil
+	}
+	return &invalidError{fmt.Errorf("%s (failing because of --strict)", msg)}
+}
+
+// checkStrict returns an error if warnings were reported with --strict.
+func checkStrict() error {
+	warnings.Lock()
+	count := warnings.count
+	warnings.Unlock()
+
+	if !Strict || count == 0 {
+		return nil
+	}
+	if count == 1 {
+		return &invalidError{fmt.Errorf("1 warning was reported, failing because of --strict")}
+	}
+	return &invalidError{fmt.Errorf("%d warnings were reported, failing because of --strict", count)}
+}
@@ -0,0 +1,31 @@
+package main
+
+import "testing"
+
+func TestWarnfStrict(t *testing.T) {
+	oldStrict, oldCount := Strict, warnings.count
+	defer func() {
+		Strict, warnings.count = oldStrict, oldCount
+	}()
+	warnings.count = 0
+
+	Strict = false
+	if err := warnf("pattern %s matches no files", "a/*.yml"); err != nil {
+		t.Errorf("expected no error without --strict, got %s", err)
+	}
+	if err := checkStrict(); err != nil {
+		t.Errorf("expected no error without --strict, got %s", err)
+	}
+
+	Strict = true
+	err := warnf("pattern %s matches no files", "b/*.yml")
+	if err == nil || err.Error() != "pattern b/*.yml matches no files (failing because of --strict)" {
+		t.Errorf("expected the warning as error with --strict, got %v", err)
+	}
+	if exitCode(err) != exitInvalid {
+		t.Errorf("expected exit code %d, got %d", exitInvalid, exitCode(err))
+	}
+	if err := checkStrict(); err == nil || err.Error() != "2 warnings were reported, failing because of --strict" {
+		t.Errorf("expected an error for the reported warnings, got %v", err)
+	}
+}
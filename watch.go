@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	defaultWatchInterval = 30 * time.Second
+
+	// minWatchInterval keeps watch mode from polling the API too often.
+	minWatchInterval = 5 * time.Second
+)
+
+func parseWatchInterval(value string) (time.Duration, error) {
+	if value == "" {
+		return defaultWatchInterval, nil
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q for --interval, expected a duration like 30s", value)
+	}
+	if interval < minWatchInterval {
+		return 0, fmt.Errorf("--interval must be at least %s", minWatchInterval)
+	}
+	return interval, nil
+}
+
+// watch calls run every interval until ctx is done, e.g. by Ctrl-C.
+func watch(ctx context.Context, interval time.Duration, run func() error) error {
+	logger.Infof("Watching for changes every %s, press Ctrl-C to stop", interval)
+	return watchLoop(interval, run, ctx.Done())
+}
+
+// watchLoop calls run every interval until stop is closed. Errors of a run are
+// logged and the next run is waited for, as the API might be unavailable for
+// a moment. Invalid configuration or options end watching, as does stop being
+// closed during a run.
+func watchLoop(interval time.Duration, run func() error, stop <-chan struct{}) error {
+	for {
+		if err := watchRun(run, stop); err != nil {
+			return err
+		}
+
+		select {
+		case <-stop:
+			logger.Infof("Stopped watching")
+			return nil
+		case <-time.After(interval):
+			// Every run has to see the current state of the project.
+			requestCache.reset()
+		}
+	}
+}
+
+const (
+	// fileWatchPoll is the time between checks of the watched files.
+	fileWatchPoll = 500 * time.Millisecond
+
+	// fileWatchDebounce is the time files have to stay unchanged before they
+	// are uploaded, so an editor writing a file several times or replacing it
+	// by renaming a temporary file triggers a single upload.
+	fileWatchDebounce = 2 * time.Second
+)
+
+// fileStates maps the paths of files to their size and modification time.
+type fileStates map[string]string
+
+func statFiles(paths []string) (fileStates, error) {
+	states := fileStates{}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			// removed in the meantime, e.g. while being replaced
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		states[path] = fmt.Sprintf("%d %d", info.Size(), info.ModTime().UnixNano())
+	}
+	return states, nil
+}
+
+func (states fileStates) equal(other fileStates) bool {
+	if len(states) != len(other) {
+		return false
+	}
+	for path, state := range states {
+		if other[path] != state {
+			return false
+		}
+	}
+	return true
+}
+
+// watchFiles calls run whenever the files returned by list changed, until ctx
+// is done, e.g. by Ctrl-C. Files are polled instead of relying on file system
+// notifications, which also catches files replaced by renaming another one.
+func watchFiles(ctx context.Context, list func() (fileStates, error), run func() error) error {
+	logger.Infof("Watching for changed files, press Ctrl-C to stop")
+	return watchFilesLoop(list, run, fileWatchPoll, fileWatchDebounce, ctx.Done())
+}
+
+// watchFilesLoop checks the files returned by list every poll and calls run
+// once they changed and then stayed unchanged for debounce, until stop is
+// closed.
+func watchFilesLoop(list func() (fileStates, error), run func() error, poll, debounce time.Duration, stop <-chan struct{}) error {
+	last, err := list()
+	if err != nil {
+		return err
+	}
+
+	var changed time.Time
+	for {
+		select {
+		case <-stop:
+			logger.Infof("Stopped watching")
+			return nil
+		case <-time.After(poll):
+		}
+
+		current, err := list()
+		if err != nil {
+			logger.Errorf("%s", err)
+			continue
+		}
+		if !current.equal(last) {
+			last, changed = current, time.Now()
+			continue
+		}
+		if changed.IsZero() || time.Since(changed) < debounce {
+			continue
+		}
+
+		changed = time.Time{}
+		requestCache.reset()
+		if err := watchRun(run, stop); err != nil {
+			return err
+		}
+	}
+}
+
+// watchRun calls run and returns the errors ending watch mode.
+func watchRun(run func() error, stop <-chan struct{}) error {
+	err := run()
+	if err == nil {
+		return nil
+	}
+	if _, invalid := err.(*invalidError); invalid {
+		return err
+	}
+	select {
+	case <-stop:
+		return err
+	default:
+	}
+	logger.Errorf("%s", err)
+	return nil
+}
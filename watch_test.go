@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseWatchInterval(t *testing.T) {
+	for value, expected := range map[string]time.Duration{"": defaultWatchInterval, "1m": time.Minute, "5s": 5 * time.Second} {
+		interval, err := parseWatchInterval(value)
+		if err != nil {
+			t.Errorf("%q: %s", value, err)
+		} else if interval != expected {
+			t.Errorf("%q: expected %s, got %s", value, expected, interval)
+		}
+	}
+
+	for _, value := range []string{"1s", "soon", "-1m"} {
+		if _, err := parseWatchInterval(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
+
+func TestWatchLoop(t *testing.T) {
+	stop := make(chan struct{})
+	runs := 0
+	err := watchLoop(time.Millisecond, func() error {
+		runs++
+		if runs == 3 {
+			close(stop)
+			return nil
+		}
+		return fmt.Errorf("temporary failure")
+	}, stop)
+	if err != nil {
+		t.Errorf("expected failed runs to be retried, got %s", err)
+	}
+	if runs != 3 {
+		t.Errorf("expected watching to stop after the interrupt, got %d runs", runs)
+	}
+
+	stop = make(chan struct{})
+	err = watchLoop(time.Millisecond, func() error {
+		close(stop)
+		return fmt.Errorf("request canceled")
+	}, stop)
+	if err == nil {
+		t.Errorf("expected the error of a run interrupted to be returned")
+	}
+
+	err = watchLoop(time.Millisecond, func() error {
+		return &invalidError{fmt.Errorf("no targets")}
+	}, make(chan struct{}))
+	if err == nil {
+		t.Errorf("expected invalid configuration to end watching")
+	}
+}
+
+func TestStatFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp-watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "en.yml")
+	if err := ioutil.WriteFile(path, []byte("en:\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	before, err := statFiles([]string{path, filepath.Join(dir, "removed.yml")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(before) != 1 {
+		t.Errorf("expected missing files to be left out, got %v", before)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("en:\n  title: Hello\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	after, err := statFiles([]string{path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.equal(before) {
+		t.Errorf("expected the changed file to change the states, got %v", after)
+	}
+}
+
+func TestWatchFilesLoop(t *testing.T) {
+	// Every list call returns the next states, the last ones repeatedly.
+	states := []fileStates{
+		{"en.yml": "1"},
+		{"en.yml": "1"},
+		{"en.yml": "2"},
+		{"en.yml": "3"},
+		{"en.yml": "3"},
+	}
+	calls := 0
+	list := func() (fileStates, error) {
+		s := states[calls]
+		if calls < len(states)-1 {
+			calls++
+		}
+		return s, nil
+	}
+
+	stop := make(chan struct{})
+	runs := 0
+	err := watchFilesLoop(list, func() error {
+		runs++
+		close(stop)
+		return nil
+	}, time.Millisecond, 5*time.Millisecond, stop)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runs != 1 {
+		t.Errorf("expected one run for the changes, got %d", runs)
+	}
+	if calls != len(states)-1 {
+		t.Errorf("expected the run to wait for the files to stay unchanged, got %d list calls", calls)
+	}
+}
@@ -188,13 +188,16 @@ func printParrot() {
 }
 
 func printErrorStr(errorMsg string) {
-	printWithColor(errorMsg, ct.Red, true)
+	printWithColor(redact(errorMsg), ct.Red, true)
 }
 func printError(err error) {
-	printWithColor(err.Error(), ct.Red, true)
+	printWithColor(redact(err.Error()), ct.Red, true)
 }
 
 func printWait(msg string) {
+	if Quiet {
+		return
+	}
 	printWithColor(msg, ct.Yellow, true)
 }
 
@@ -205,6 +208,9 @@ func printWithColor(msg string, color ct.Color, bright bool) {
 }
 
 func printSuccess(msg string) {
+	if Quiet {
+		return
+	}
 	printWithColor(msg, ct.Green, true)
 }
 
@@ -390,21 +396,25 @@ func writeConfig(data *WizardData, filename string) error {
 	var initialPush string
 	fmt.Print("Enter \"y\" to upload your locales now for the first time (Default: \"y\"): ")
 	initialPush = prompt()
-	if initialPush == "y" || initialPush == "" {
-		err = firstPush()
+	if initialPush == "" || isYes(initialPush) {
+		printWait("Pushing...")
+		err = firstPush(bytes)
 		if err != nil {
-			return err
+			printError(fmt.Errorf("Initial push failed: %s", err))
+			fmt.Println("Fix the problem and run \"phraseapp push\" to upload your locales.")
+			return nil
 		}
 	}
 	fmt.Println("Setup completed!")
 	return nil
 }
 
-func firstPush() error {
-	cfg, err := phraseapp.ReadConfig()
+// firstPush uploads the locales using the configuration just generated by the
+// wizard, instead of whatever configuration the environment points to.
+func firstPush(content []byte) error {
+	cfg, err := ParseConfig(content)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
-		os.Exit(2)
+		return err
 	}
 	cmd := &PushCommand{Config: cfg}
 	return cmd.Run()
@@ -452,6 +462,15 @@ func prompt() string {
 	return string(bytes)
 }
 
+// isYes reports whether the answer to a yes/no prompt was affirmative.
+func isYes(answer string) bool {
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
+
 func newProjectStep(data *WizardData) error {
 	fmt.Print("Enter name of new project: ")
 	projectParam := &phraseapp.ProjectParams{}
@@ -519,7 +538,7 @@ func selectProjectStep(data *WizardData) error {
 	if err != nil {
 		unauth_match, match_err := regexp.MatchString("401", err.Error())
 		if match_err != nil {
-			fmt.Fprintln(os.Stderr, err.Error())
+			fmt.Fprintln(os.Stderr, redact(err.Error()))
 			panic(match_err)
 		}
 		if unauth_match {
@@ -536,7 +555,7 @@ func selectProjectStep(data *WizardData) error {
 		data.MainFormat = projects[0].MainFormat
 		fmt.Printf("You've got one project, \"%s\". Answer \"y\" to select this or \"n\" to create a new project: ", projects[0].Name)
 		answer := prompt()
-		if answer == "y" {
+		if isYes(answer) {
 			return DisplayWizard(data, next(data), "")
 		} else {
 			data.ProjectID = ""